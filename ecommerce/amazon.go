@@ -2,41 +2,48 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
+// amazonUrlSource describes the amazon source.
+var amazonUrlSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonUrl,
+	Input:  internal.InputUrl,
+	Host:   "amazon",
+	Params: []internal.Param{
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+}
+
 // AmazonUrlOpts contains all the query parameters available for amazon.
 type AmazonUrlOpts struct {
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonUrl parameters.
-func (opt *AmazonUrlOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// params returns the AmazonUrlOpts as request parameters.
+func (opt *AmazonUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
@@ -50,6 +57,15 @@ func (c *EcommerceClient) ScrapeAmazonUrl(
 	return c.ScrapeAmazonUrlCtx(ctx, url, opts...)
 }
 
+// BuildAmazonUrlPayload returns the JSON payload that ScrapeAmazonUrl would send
+// for the given url and options, without sending it.
+func BuildAmazonUrlPayload(
+	url string,
+	opts ...*AmazonUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonUrl, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonUrlCtx scrapes amazon via Oxylabs E-Commerce API with amazon as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonUrlCtx(
@@ -57,101 +73,66 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 	url string,
 	opts ...*AmazonUrlOpts,
 ) (*Resp, error) {
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "amazon")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &AmazonUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	//Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.AmazonUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// amazonSearchContextKeys contains the context options accepted by AmazonSearchOpts.
+var amazonSearchContextKeys = []internal.ContextKey{
+	{Name: "category_id", Type: internal.ValueInt},
+	{Name: "merchant_id", Type: internal.ValueInt},
+}
 
-	return resp, nil
+// amazonSearchSource describes the amazon_search source.
+var amazonSearchSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonSearch,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: amazonSearchContextKeys,
 }
 
 // AmazonSearchOpts contains all the query parameters available for amazon_search.
 type AmazonSearchOpts struct {
-	Domain            oxylabs.Domain
-	StartPage         int
-	Pages             int
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	Context           []func(oxylabs.ContextOption)
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonSearch parameters.
-func (opt *AmazonSearchOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+	Domain              oxylabs.Domain
+	StartPage           int
+	Pages               int
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonSearchOpts as request parameters.
+func (opt *AmazonSearchOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
 }
 
 // ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
@@ -165,6 +146,15 @@ func (c *EcommerceClient) ScrapeAmazonSearch(
 	return c.ScrapeAmazonSearchCtx(ctx, query, opts...)
 }
 
+// BuildAmazonSearchPayload returns the JSON payload that ScrapeAmazonSearch would send
+// for the given query and options, without sending it.
+func BuildAmazonSearchPayload(
+	query string,
+	opts ...*AmazonSearchOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonSearch, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonSearchCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonSearchCtx(
@@ -172,112 +162,82 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 	query string,
 	opts ...*AmazonSearchOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-			{
-				"key":   "merchant_id",
-				"value": context["merchant_id"],
-			},
-		},
-	}
+	return c.Scrape(ctx, oxylabs.AmazonSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+// ScrapeAmazonSearchParsed scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// and returns the parsed content of the results as AmazonSearchContent.
+func (c *EcommerceClient) ScrapeAmazonSearchParsed(
+	query string,
+	opts ...*AmazonSearchOpts,
+) (*response.Resp[AmazonSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.ScrapeAmazonSearchParsedCtx(ctx, query, opts...)
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonSearchParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// and returns the parsed content of the results as AmazonSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) (*response.Resp[AmazonSearchContent], error) {
+	return scrapeParsed[AmazonSearchContent](ctx, c, oxylabs.AmazonSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// amazonProductContextKeys contains the context options accepted by AmazonProductOpts.
+var amazonProductContextKeys = []internal.ContextKey{
+	{Name: "autoselect_variant", Type: internal.ValueBool},
+}
 
-	return resp, nil
+// amazonProductSource describes the amazon_product source.
+var amazonProductSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonProduct,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: amazonProductContextKeys,
 }
 
 // AmazonProductOpts contains all the query parameters available for amazon_product.
 type AmazonProductOpts struct {
-	Domain            oxylabs.Domain
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	Context           []func(oxylabs.ContextOption)
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonProduct parameters.
-func (opt *AmazonProductOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+	Domain              oxylabs.Domain
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonProductOpts as request parameters.
+func (opt *AmazonProductOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
 }
 
 // ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
@@ -291,6 +251,15 @@ func (c *EcommerceClient) ScrapeAmazonProduct(
 	return c.ScrapeAmazonProductCtx(ctx, query, opts...)
 }
 
+// BuildAmazonProductPayload returns the JSON payload that ScrapeAmazonProduct would send
+// for the given query and options, without sending it.
+func BuildAmazonProductPayload(
+	query string,
+	opts ...*AmazonProductOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonProduct, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonProductCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonProductCtx(
@@ -298,109 +267,78 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 	query string,
 	opts ...*AmazonProductOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonProduct,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "autoselect_variant",
-				"value": context["autoselect_variant"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.AmazonProduct, query, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonProductParsed scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// and returns the parsed content of the results as AmazonProductContent.
+func (c *EcommerceClient) ScrapeAmazonProductParsed(
+	query string,
+	opts ...*AmazonProductOpts,
+) (*response.Resp[AmazonProductContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonProductParsedCtx(ctx, query, opts...)
+}
 
-	return resp, nil
+// ScrapeAmazonProductParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// and returns the parsed content of the results as AmazonProductContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonProductParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) (*response.Resp[AmazonProductContent], error) {
+	return scrapeParsed[AmazonProductContent](ctx, c, oxylabs.AmazonProduct, query, internal.LastOpt(opts).params())
+}
+
+// amazonPricingSource describes the amazon_pricing source.
+var amazonPricingSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonPricing,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // AmazonPricingOpts contains all the query parameters available for amazon_pricing.
 type AmazonPricingOpts struct {
-	Domain            oxylabs.Domain
-	StartPage         int
-	Pages             int
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonPricing parameters.
-func (opt *AmazonPricingOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+	Domain              oxylabs.Domain
+	StartPage           int
+	Pages               int
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonPricingOpts as request parameters.
+func (opt *AmazonPricingOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
@@ -414,6 +352,15 @@ func (c *EcommerceClient) ScrapeAmazonPricing(
 	return c.ScrapeAmazonPricingCtx(ctx, query, opts...)
 }
 
+// BuildAmazonPricingPayload returns the JSON payload that ScrapeAmazonPricing would send
+// for the given query and options, without sending it.
+func BuildAmazonPricingPayload(
+	query string,
+	opts ...*AmazonPricingOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonPricing, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonPricingCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonPricingCtx(
@@ -421,101 +368,78 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 	query string,
 	opts ...*AmazonPricingOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonPricing,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.AmazonPricing, query, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonPricingParsed scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// and returns the parsed content of the results as AmazonPricingContent.
+func (c *EcommerceClient) ScrapeAmazonPricingParsed(
+	query string,
+	opts ...*AmazonPricingOpts,
+) (*response.Resp[AmazonPricingContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonPricingParsedCtx(ctx, query, opts...)
+}
 
-	return resp, nil
+// ScrapeAmazonPricingParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// and returns the parsed content of the results as AmazonPricingContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonPricingParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) (*response.Resp[AmazonPricingContent], error) {
+	return scrapeParsed[AmazonPricingContent](ctx, c, oxylabs.AmazonPricing, query, internal.LastOpt(opts).params())
+}
+
+// amazonReviewsSource describes the amazon_reviews source.
+var amazonReviewsSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonReviews,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // AmazonReviewsOpts contains all the query parameters available for amazon_reviews.
 type AmazonReviewsOpts struct {
-	Domain            oxylabs.Domain
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	StartPage         int
-	Pages             int
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonReviews parameters.
-func (opt *AmazonReviewsOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+	Domain              oxylabs.Domain
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	StartPage           int
+	Pages               int
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonReviewsOpts as request parameters.
+func (opt *AmazonReviewsOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
 }
 
 // ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
@@ -529,6 +453,15 @@ func (c *EcommerceClient) ScrapeAmazonReviews(
 	return c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
 }
 
+// BuildAmazonReviewsPayload returns the JSON payload that ScrapeAmazonReviews would send
+// for the given query and options, without sending it.
+func BuildAmazonReviewsPayload(
+	query string,
+	opts ...*AmazonReviewsOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonReviews, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonReviewsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
@@ -536,95 +469,72 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 	query string,
 	opts ...*AmazonReviewsOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonReviewsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonReviews,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.AmazonReviews, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeAmazonReviewsParsed scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// and returns the parsed content of the results as AmazonReviewsContent.
+func (c *EcommerceClient) ScrapeAmazonReviewsParsed(
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (*response.Resp[AmazonReviewsContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonReviewsParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonReviewsParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// and returns the parsed content of the results as AmazonReviewsContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonReviewsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (*response.Resp[AmazonReviewsContent], error) {
+	return scrapeParsed[AmazonReviewsContent](ctx, c, oxylabs.AmazonReviews, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// amazonQuestionsSource describes the amazon_questions source.
+var amazonQuestionsSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonQuestions,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // AmazonQuestionsOpts contains all the query parameters available for amazon_questions.
 type AmazonQuestionsOpts struct {
-	Domain            oxylabs.Domain
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonQuestions parameters.
-func (opt *AmazonQuestionsOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+	Domain              oxylabs.Domain
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonQuestionsOpts as request parameters.
+func (opt *AmazonQuestionsOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
@@ -638,6 +548,15 @@ func (c *EcommerceClient) ScrapeAmazonQuestions(
 	return c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
 }
 
+// BuildAmazonQuestionsPayload returns the JSON payload that ScrapeAmazonQuestions would send
+// for the given query and options, without sending it.
+func BuildAmazonQuestionsPayload(
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonQuestions, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonQuestionsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
@@ -645,97 +564,78 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 	query string,
 	opts ...*AmazonQuestionsOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonQuestionsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonQuestions,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.AmazonQuestions, query, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonQuestionsParsed scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// and returns the parsed content of the results as AmazonQuestionsContent.
+func (c *EcommerceClient) ScrapeAmazonQuestionsParsed(
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (*response.Resp[AmazonQuestionsContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonQuestionsParsedCtx(ctx, query, opts...)
+}
 
-	return resp, nil
+// ScrapeAmazonQuestionsParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// and returns the parsed content of the results as AmazonQuestionsContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonQuestionsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (*response.Resp[AmazonQuestionsContent], error) {
+	return scrapeParsed[AmazonQuestionsContent](ctx, c, oxylabs.AmazonQuestions, query, internal.LastOpt(opts).params())
+}
+
+// amazonBestsellersSource describes the amazon_bestsellers source.
+var amazonBestsellersSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonBestsellers,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // AmazonBestsellersOpts contains all the query parameters available for amazon_bestsellers.
 type AmazonBestsellersOpts struct {
-	Domain            oxylabs.Domain
-	StartPage         int
-	Pages             int
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonBestsellers parameters.
-func (opt *AmazonBestsellersOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+	Domain              oxylabs.Domain
+	StartPage           int
+	Pages               int
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonBestsellersOpts as request parameters.
+func (opt *AmazonBestsellersOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
 }
 
 // ScrapeAmazonBestsellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
@@ -749,6 +649,15 @@ func (c *EcommerceClient) ScrapeAmazonBestsellers(
 	return c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
 }
 
+// BuildAmazonBestsellersPayload returns the JSON payload that ScrapeAmazonBestsellers would send
+// for the given query and options, without sending it.
+func BuildAmazonBestsellersPayload(
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonBestsellers, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonBestsellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
@@ -756,95 +665,72 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 	query string,
 	opts ...*AmazonBestsellersOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonBestsellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonBestsellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.AmazonBestsellers, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeAmazonBestsellersParsed scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// and returns the parsed content of the results as AmazonBestsellersContent.
+func (c *EcommerceClient) ScrapeAmazonBestsellersParsed(
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (*response.Resp[AmazonBestsellersContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonBestsellersParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonBestsellersParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// and returns the parsed content of the results as AmazonBestsellersContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonBestsellersParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (*response.Resp[AmazonBestsellersContent], error) {
+	return scrapeParsed[AmazonBestsellersContent](ctx, c, oxylabs.AmazonBestsellers, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// amazonSellersSource describes the amazon_sellers source.
+var amazonSellersSource = &internal.SourceSpec{
+	Source: oxylabs.AmazonSellers,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // AmazonSellersOpts contains all the query parameters available for amazon_seller.
 type AmazonSellersOpts struct {
-	Domain            oxylabs.Domain
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeAmazonSeller parameters.
-func (opt *AmazonSellersOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+	Domain              oxylabs.Domain
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the AmazonSellersOpts as request parameters.
+func (opt *AmazonSellersOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
@@ -858,6 +744,15 @@ func (c *EcommerceClient) ScrapeAmazonSellers(
 	return c.ScrapeAmazonSellersCtx(ctx, query, opts...)
 }
 
+// BuildAmazonSellersPayload returns the JSON payload that ScrapeAmazonSellers would send
+// for the given query and options, without sending it.
+func BuildAmazonSellersPayload(
+	query string,
+	opts ...*AmazonSellersOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.AmazonSellers, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeAmazonSellerCtx scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeAmazonSellersCtx(
@@ -865,58 +760,28 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 	query string,
 	opts ...*AmazonSellersOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &AmazonSellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.AmazonSellers, query, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeAmazonSellersParsed scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// and returns the parsed content of the results as AmazonSellersContent.
+func (c *EcommerceClient) ScrapeAmazonSellersParsed(
+	query string,
+	opts ...*AmazonSellersOpts,
+) (*response.Resp[AmazonSellersContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeAmazonSellersParsedCtx(ctx, query, opts...)
+}
 
-	return resp, nil
+// ScrapeAmazonSellersParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// and returns the parsed content of the results as AmazonSellersContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonSellersParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) (*response.Resp[AmazonSellersContent], error) {
+	return scrapeParsed[AmazonSellersContent](ctx, c, oxylabs.AmazonSellers, query, internal.LastOpt(opts).params())
 }
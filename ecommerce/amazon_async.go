@@ -2,12 +2,10 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
@@ -28,89 +26,7 @@ func (c *EcommerceClientAsync) ScrapeAmazonUrlCtx(
 	url string,
 	opts ...*AmazonUrlOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	/// Check validity of url.
-	err := internal.ValidateUrl(url, "amazon")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &AmazonUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	//Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonUrl, url, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
@@ -131,106 +47,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonSearchCtx(
 	query string,
 	opts ...*AmazonSearchOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-			{
-				"key":   "merchant_id",
-				"value": context["merchant_id"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonSearch, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonSearchParsed scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// and returns the parsed content of the results as AmazonSearchContent.
+func (c *EcommerceClientAsync) ScrapeAmazonSearchParsed(
+	query string,
+	opts ...*AmazonSearchOpts,
+) (chan *response.Resp[AmazonSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonSearchParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonSearchParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// and returns the parsed content of the results as AmazonSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) (chan *response.Resp[AmazonSearchContent], error) {
+	return scrapeParsedAsync[AmazonSearchContent](ctx, c, oxylabs.AmazonSearch, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
@@ -251,98 +91,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonProductCtx(
 	query string,
 	opts ...*AmazonProductOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonProduct,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "autoselect_variant",
-				"value": context["autoselect_variant"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonProduct, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonProductParsed scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// and returns the parsed content of the results as AmazonProductContent.
+func (c *EcommerceClientAsync) ScrapeAmazonProductParsed(
+	query string,
+	opts ...*AmazonProductOpts,
+) (chan *response.Resp[AmazonProductContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonProductParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonProductParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// and returns the parsed content of the results as AmazonProductContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonProductParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) (chan *response.Resp[AmazonProductContent], error) {
+	return scrapeParsedAsync[AmazonProductContent](ctx, c, oxylabs.AmazonProduct, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
@@ -363,90 +135,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonPricingCtx(
 	query string,
 	opts ...*AmazonPricingOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonPricing,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonPricing, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonPricingParsed scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// and returns the parsed content of the results as AmazonPricingContent.
+func (c *EcommerceClientAsync) ScrapeAmazonPricingParsed(
+	query string,
+	opts ...*AmazonPricingOpts,
+) (chan *response.Resp[AmazonPricingContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonPricingParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonPricingParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// and returns the parsed content of the results as AmazonPricingContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonPricingParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) (chan *response.Resp[AmazonPricingContent], error) {
+	return scrapeParsedAsync[AmazonPricingContent](ctx, c, oxylabs.AmazonPricing, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
@@ -467,90 +179,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonReviewsCtx(
 	query string,
 	opts ...*AmazonReviewsOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonReviewsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonReviews,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonReviews, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonReviewsParsed scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// and returns the parsed content of the results as AmazonReviewsContent.
+func (c *EcommerceClientAsync) ScrapeAmazonReviewsParsed(
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (chan *response.Resp[AmazonReviewsContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonReviewsParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonReviewsParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// and returns the parsed content of the results as AmazonReviewsContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonReviewsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (chan *response.Resp[AmazonReviewsContent], error) {
+	return scrapeParsedAsync[AmazonReviewsContent](ctx, c, oxylabs.AmazonReviews, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
@@ -571,86 +223,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonQuestionsCtx(
 	query string,
 	opts ...*AmazonQuestionsOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonQuestionsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonQuestions,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonQuestions, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonQuestionsParsed scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// and returns the parsed content of the results as AmazonQuestionsContent.
+func (c *EcommerceClientAsync) ScrapeAmazonQuestionsParsed(
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (chan *response.Resp[AmazonQuestionsContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonQuestionsParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonQuestionsParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// and returns the parsed content of the results as AmazonQuestionsContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonQuestionsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (chan *response.Resp[AmazonQuestionsContent], error) {
+	return scrapeParsedAsync[AmazonQuestionsContent](ctx, c, oxylabs.AmazonQuestions, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonBestSellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
@@ -671,90 +267,30 @@ func (c *EcommerceClientAsync) ScrapeAmazonBestsellersCtx(
 	query string,
 	opts ...*AmazonBestsellersOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonBestsellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonBestsellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonBestsellers, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonBestsellersParsed scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// and returns the parsed content of the results as AmazonBestsellersContent.
+func (c *EcommerceClientAsync) ScrapeAmazonBestsellersParsed(
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (chan *response.Resp[AmazonBestsellersContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonBestsellersParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonBestsellersParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// and returns the parsed content of the results as AmazonBestsellersContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonBestsellersParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (chan *response.Resp[AmazonBestsellersContent], error) {
+	return scrapeParsedAsync[AmazonBestsellersContent](ctx, c, oxylabs.AmazonBestsellers, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source.
@@ -775,84 +311,28 @@ func (c *EcommerceClientAsync) ScrapeAmazonSellersCtx(
 	query string,
 	opts ...*AmazonSellersOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonSellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.AmazonSellers, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeAmazonSellersParsed scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// and returns the parsed content of the results as AmazonSellersContent.
+func (c *EcommerceClientAsync) ScrapeAmazonSellersParsed(
+	query string,
+	opts ...*AmazonSellersOpts,
+) (chan *response.Resp[AmazonSellersContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeAmazonSellersParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonSellersParsedCtx scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// and returns the parsed content of the results as AmazonSellersContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeAmazonSellersParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) (chan *response.Resp[AmazonSellersContent], error) {
+	return scrapeParsedAsync[AmazonSellersContent](ctx, c, oxylabs.AmazonSellers, query, internal.LastOpt(opts).params())
 }
@@ -0,0 +1,136 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAmazonSearchPayload(t *testing.T) {
+	jsonPayload, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			Domain: oxylabs.DOMAIN_DE,
+			Parse:  true,
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, "amazon_search", payload["source"])
+	assert.Equal(t, "nike", payload["query"])
+	assert.Equal(t, "de", payload["domain"])
+	assert.Equal(t, true, payload["parse"])
+	assert.Equal(t, float64(1), payload["start_page"])
+	assert.Equal(t, float64(1), payload["pages"])
+}
+
+func TestBuildAmazonUrlPayload_InvalidUrl(t *testing.T) {
+	_, err := BuildAmazonUrlPayload("https://www.example.com/dp/B0000000")
+	assert.Error(t, err)
+}
+
+func TestBuildAmazonSearchPayload_UnsupportedContext(t *testing.T) {
+	_, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			Context: []func(oxylabs.ContextOption){
+				oxylabs.HotelDates("2024-01-01,2024-01-02"),
+			},
+		},
+	)
+	assert.ErrorContains(t, err, "invalid hotel_dates parameter: unsupported context option")
+}
+
+func TestBuildAmazonSearchPayload_InvalidContextType(t *testing.T) {
+	_, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			Context: []func(oxylabs.ContextOption){
+				func(ctx oxylabs.ContextOption) {
+					ctx["category_id"] = "electronics"
+				},
+			},
+		},
+	)
+
+	var validationErr *oxylabs.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "category_id", validationErr.Key)
+}
+
+func TestBuildAmazonSearchPayload_LenientContext(t *testing.T) {
+	jsonPayload, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			LenientContext: true,
+			Context: []func(oxylabs.ContextOption){
+				oxylabs.CategoryId(123),
+				func(ctx oxylabs.ContextOption) {
+					ctx["new_option"] = "value"
+				},
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "category_id", "value": float64(123)},
+		map[string]interface{}{"key": "new_option", "value": "value"},
+	}, payload["context"])
+}
+
+func TestBuildAmazonSearchPayload_CoercesJSONContext(t *testing.T) {
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"category_id": 123, "merchant_id": 456}`), &decoded))
+
+	jsonPayload, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			Context: []func(oxylabs.ContextOption){
+				func(ctx oxylabs.ContextOption) {
+					for key, value := range decoded {
+						ctx[key] = value
+					}
+				},
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "category_id", "value": float64(123)},
+		map[string]interface{}{"key": "merchant_id", "value": float64(456)},
+	}, payload["context"])
+}
+
+func TestBuildAmazonSearchPayload_ParsingInstructions(t *testing.T) {
+	instructions := map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []map[string]interface{}{
+				{"_fn": "xpath_one", "_args": []string{"//h1/text()"}},
+			},
+		},
+	}
+
+	jsonPayload, err := BuildAmazonSearchPayload(
+		"nike",
+		&AmazonSearchOpts{
+			ParseInstructions: &instructions,
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, true, payload["parse"])
+	assert.Contains(t, payload["parsing_instructions"], "title")
+	assert.NotContains(t, payload, "parse_instructions")
+}
@@ -1,9 +1,11 @@
 package ecommerce
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 type EcommerceClient struct {
@@ -47,3 +49,53 @@ func InitAsync(
 		},
 	}
 }
+
+// EnableStrictDecode enables the strict decode mode, in which the JSON of the
+// parsed content and of the job of every response is compared with the structs
+// they are decoded into. The differences are set as the Diagnostics of the
+// response and passed to the hook, e.g. to log them, but do not fail the request.
+func (c *EcommerceClient) EnableStrictDecode(hook oxylabs.DiagnosticHook) {
+	c.C.EnableStrictDecode(hook)
+}
+
+// EnableStrictDecode enables the strict decode mode, in which the JSON of the
+// parsed content and of the job of every response is compared with the structs
+// they are decoded into. The differences are set as the Diagnostics of the
+// response and passed to the hook, e.g. to log them, but do not fail the request.
+func (c *EcommerceClientAsync) EnableStrictDecode(hook oxylabs.DiagnosticHook) {
+	c.C.EnableStrictDecode(hook)
+}
+
+// EnableStrictParse enables the strict parse mode, in which scraping fails
+// with an *oxylabs.ParseError when the API fails to parse some of the pages,
+// instead of returning their empty parsed content.
+func (c *EcommerceClient) EnableStrictParse() {
+	c.C.EnableStrictParse()
+}
+
+// EnableStrictParse enables the strict parse mode, in which scraping fails
+// with an *oxylabs.ParseError when the API fails to parse some of the pages,
+// instead of returning their empty parsed content.
+func (c *EcommerceClientAsync) EnableStrictParse() {
+	c.C.EnableStrictParse()
+}
+
+// FollowLink requests the resource of a link of a job, e.g. the link of
+// its results returned by Job.Link(oxylabs.JobLinkResults). The response
+// of a results link can be decoded with GetResp.
+func (c *EcommerceClient) FollowLink(
+	ctx context.Context,
+	link oxylabs.JobLink,
+) (*http.Response, error) {
+	return c.C.FollowLink(ctx, link)
+}
+
+// FollowLink requests the resource of a link of a job, e.g. the link of
+// its results returned by Job.Link(oxylabs.JobLinkResults). The response
+// of a results link can be decoded with GetResp.
+func (c *EcommerceClientAsync) FollowLink(
+	ctx context.Context,
+	link oxylabs.JobLink,
+) (*http.Response, error) {
+	return c.C.FollowLink(ctx, link)
+}
@@ -0,0 +1,38 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+func FuzzContextValidation(f *testing.F) {
+	f.Add([]byte(`{"category_id": 12.5, "merchant_id": "123"}`))
+	f.Add([]byte(`{"autoselect_variant": 1}`))
+	f.Add([]byte(`{"sort_by": 3, "min_price": -1, "max_price": null}`))
+	f.Add([]byte(`{"nfpr": "true", "min_price": 10}`))
+	f.Add([]byte(`{"headers": ["a"], "cookies": {"key": 1}, "successful_status_codes": [200, 1.5]}`))
+	f.Add([]byte(`{"http_method": "post", "content": {}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return
+		}
+
+		context := []func(oxylabs.ContextOption){
+			func(ctx oxylabs.ContextOption) {
+				for key, value := range decoded {
+					ctx[key] = value
+				}
+			},
+		}
+
+		// Only panics are failures, validation errors are expected.
+		BuildAmazonSearchPayload("query", &AmazonSearchOpts{Context: context})
+		BuildAmazonProductPayload("B0000000", &AmazonProductOpts{Context: context})
+		BuildGoogleShoppingSearchPayload("query", &GoogleShoppingSearchOpts{Context: context})
+		BuildUniversalUrlPayload("https://example.com", &UniversalUrlOpts{Context: context})
+	})
+}
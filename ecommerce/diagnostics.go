@@ -0,0 +1,39 @@
+package ecommerce
+
+import (
+	"reflect"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// contentModels contains the models of the parsed content of the sources
+// that have a typed response.
+var contentModels = map[oxylabs.Source]reflect.Type{
+	oxylabs.AmazonSearch:          reflect.TypeOf(AmazonSearchContent{}),
+	oxylabs.AmazonProduct:         reflect.TypeOf(AmazonProductContent{}),
+	oxylabs.AmazonPricing:         reflect.TypeOf(AmazonPricingContent{}),
+	oxylabs.AmazonReviews:         reflect.TypeOf(AmazonReviewsContent{}),
+	oxylabs.AmazonQuestions:       reflect.TypeOf(AmazonQuestionsContent{}),
+	oxylabs.AmazonBestsellers:     reflect.TypeOf(AmazonBestsellersContent{}),
+	oxylabs.AmazonSellers:         reflect.TypeOf(AmazonSellersContent{}),
+	oxylabs.GoogleShoppingSearch:  reflect.TypeOf(GoogleShoppingSearchContent{}),
+	oxylabs.GoogleShoppingProduct: reflect.TypeOf(GoogleShoppingProductContent{}),
+	oxylabs.GoogleShoppingPricing: reflect.TypeOf(GoogleShoppingPricingContent{}),
+}
+
+// Diagnose compares the JSON of the parsed content of the results and of the
+// job with the structs they are decoded into, and returns the unknown fields,
+// the type mismatches and the missing fields. The content is compared with the
+// typed content of the source, e.g. AmazonProductContent. Missing fields are
+// not reported for sources without a typed content, since Content contains
+// the fields of every source.
+func (r *Resp) Diagnose() []oxylabs.Diagnostic {
+	return diagnose(&r.Resp)
+}
+
+// diagnose returns the diagnostics of the response with the models of the
+// sources, see Resp.Diagnose.
+func diagnose(r *response.Resp[Content]) []oxylabs.Diagnostic {
+	return r.DiagnoseWith(contentModels)
+}
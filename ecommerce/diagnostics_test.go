@@ -0,0 +1,65 @@
+package ecommerce
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// diagnosticsGolden compares the diagnostics with the golden file of the fixture.
+func diagnosticsGolden(t *testing.T, fixture string, diagnostics []oxylabs.Diagnostic) {
+	var b strings.Builder
+	for _, d := range diagnostics {
+		b.WriteString(d.String() + "\n")
+	}
+
+	golden := filepath.Join("testdata", strings.TrimSuffix(fixture, ".json")+".diagnostics.golden")
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, []byte(b.String()), 0644))
+	}
+
+	want, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), b.String())
+}
+
+func TestResp_Diagnose(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "amazon_product.json"))
+	assert.NoError(t, err)
+
+	// The reviews count that is not a number does not fail the decoding.
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+	assert.Equal(t, oxylabs.Int{Present: true, Raw: `"1,043,543"`}, resp.Results[0].ContentParsed.ReviewsCount)
+
+	resp = &Resp{Resp: response.Resp[Content]{Parse: true, StrictDecode: true}}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+	assert.Equal(t, oxylabs.NewPrice(39.99), resp.Results[0].ContentParsed.Price)
+	assert.Equal(t, oxylabs.NewPrice(49.99), resp.Results[0].ContentParsed.PriceInitial)
+	assert.Equal(t, oxylabs.Int{Present: true, Raw: `"1,043,543"`}, resp.Results[0].ContentParsed.ReviewsCount)
+	diagnosticsGolden(t, "amazon_product.json", resp.Diagnose())
+}
+
+func TestResp_DiagnoseUnparsed(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{StrictDecode: true}}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(`{"results": [{"content": "<html></html>"}], "job": {"id": 1}}`)))
+
+	diagnostics := resp.Diagnose()
+	assert.Contains(t, diagnostics, oxylabs.Diagnostic{
+		Kind:     oxylabs.TypeMismatch,
+		Path:     "/job/id",
+		Expected: "string",
+		Got:      "integer",
+	})
+	for _, d := range diagnostics {
+		assert.NotContains(t, d.Path, "/results")
+	}
+}
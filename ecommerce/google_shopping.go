@@ -2,12 +2,12 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // Accepted parameters for context options in google shopping.
@@ -18,36 +18,61 @@ var AcceptedSortByParameters = []string{
 	"pd",
 }
 
-// GoogleShoppingUrlOpts contains all the query parameters available for google shopping.
-type GoogleShoppingUrlOpts struct {
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	GeoLocation       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleShoppingUrl parameters.
-func (opt *GoogleShoppingUrlOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+// validateShoppingSearch checks validity of the sort_by, min_price and max_price context options.
+func validateShoppingSearch(params map[string]interface{}, ctx oxylabs.ContextOption) error {
+	if sortBy, ok := ctx["sort_by"].(string); ok && !internal.InList(sortBy, AcceptedSortByParameters) {
+		return fmt.Errorf("invalid sort_by parameter: %v", sortBy)
 	}
 
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+	minPrice, _ := ctx["min_price"].(int)
+	maxPrice, _ := ctx["max_price"].(int)
+	if minPrice < 0 || maxPrice < 0 {
+		return fmt.Errorf("min and max prices should be greater than 0")
 	}
 
 	return nil
 }
 
+// googleShoppingUrlSource describes the google_shopping source.
+var googleShoppingUrlSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleShoppingUrl,
+	Input:  internal.InputUrl,
+	Host:   "shopping.google",
+	Params: []internal.Param{
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+}
+
+// GoogleShoppingUrlOpts contains all the query parameters available for google shopping.
+type GoogleShoppingUrlOpts struct {
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	GeoLocation         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the GoogleShoppingUrlOpts as request parameters.
+func (opt *GoogleShoppingUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"geo_location":         opt.GeoLocation,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
+}
+
 // ScrapeGoogleShoppingUrl scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
 func (c *EcommerceClient) ScrapeGoogleShoppingUrl(
 	url string,
@@ -59,6 +84,15 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrl(
 	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
 }
 
+// BuildGoogleShoppingUrlPayload returns the JSON payload that ScrapeGoogleShoppingUrl would send
+// for the given url and options, without sending it.
+func BuildGoogleShoppingUrlPayload(
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleShoppingUrl, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleShoppingUrlCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
@@ -66,112 +100,72 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (*Resp, error) {
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "shopping.google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleShoppingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleShoppingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// googleShoppingSearchContextKeys contains the context options accepted by GoogleShoppingSearchOpts.
+var googleShoppingSearchContextKeys = []internal.ContextKey{
+	{Name: "nfpr", Type: internal.ValueBool},
+	{Name: "sort_by", Type: internal.ValueString, Default: "r"},
+	{Name: "min_price", Type: internal.ValueInt},
+	{Name: "max_price", Type: internal.ValueInt},
+}
 
-	return resp, nil
+// googleShoppingSearchSource describes the google_shopping_search source.
+var googleShoppingSearchSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleShoppingSearch,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "results_language", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: googleShoppingSearchContextKeys,
+	Validate:    validateShoppingSearch,
 }
 
 // GoogleShoppingSearchOpts contains all the query parameters available for google shopping search.
 type GoogleShoppingSearchOpts struct {
-	StartPage         int
-	Pages             int
-	Locale            oxylabs.Locale
-	ResultsLanguage   string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackURL       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleShoppingSearch parameters.
-func (opt *GoogleShoppingSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if ctx["sort_by"] != nil && !internal.InList(ctx["sort_by"].(string), AcceptedSortByParameters) {
-		return fmt.Errorf("invalid sort_by parameter: %v", ctx["sort_by"])
-	}
-
-	if (ctx["min_price"] != nil || ctx["max_price"] != nil) &&
-		(ctx["min_price"].(int) < 0 || ctx["max_price"].(int) < 0) {
-		return fmt.Errorf("min and max prices should be greater than 0")
-	}
+	StartPage           int
+	Pages               int
+	Locale              oxylabs.Locale
+	ResultsLanguage     string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackURL         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// params returns the GoogleShoppingSearchOpts as request parameters.
+func (opt *GoogleShoppingSearchOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"locale":               opt.Locale,
+		"results_language":     opt.ResultsLanguage,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackURL,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
 	}
-
-	return nil
 }
 
 // ScrapeGoogleShoppingSearch scrapes google shopping via Oxylabs E-Commerce API
@@ -186,6 +180,15 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearch(
 	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
 }
 
+// BuildGoogleShoppingSearchPayload returns the JSON payload that ScrapeGoogleShoppingSearch would send
+// for the given query and options, without sending it.
+func BuildGoogleShoppingSearchPayload(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleShoppingSearch, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleShoppingSearchCtx scrapes google shopping via Oxylabs E-Commerce API
 // with google_shopping_search as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
@@ -194,121 +197,77 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleShoppingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultSortBy(context)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingSearch,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "sort_by",
-				"value": context["sort_by"],
-			},
-			{
-				"key":   "min_price",
-				"value": context["min_price"],
-			},
-			{
-				"key":   "max_price",
-				"value": context["max_price"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeGoogleShoppingSearchParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source
+// and returns the parsed content of the results as GoogleShoppingSearchContent.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchParsed(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*response.Resp[GoogleShoppingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeGoogleShoppingSearchParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingSearchParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source
+// and returns the parsed content of the results as GoogleShoppingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*response.Resp[GoogleShoppingSearchContent], error) {
+	return scrapeParsed[GoogleShoppingSearchContent](ctx, c, oxylabs.GoogleShoppingSearch, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// googleShoppingProductSource describes the google_shopping_product source.
+var googleShoppingProductSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleShoppingProduct,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "results_language", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // GoogleShoppingProductOpts contains all the query parameters available for google shopping product.
 type GoogleShoppingProductOpts struct {
-	Locale            oxylabs.Locale
-	ResultsLanguage   string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackURL       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleShoppingProduct parameters.
-func (opt *GoogleShoppingProductOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
+	Locale              oxylabs.Locale
+	ResultsLanguage     string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackURL         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// params returns the GoogleShoppingProductOpts as request parameters.
+func (opt *GoogleShoppingProductOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"locale":               opt.Locale,
+		"results_language":     opt.ResultsLanguage,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackURL,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeGoogleShoppingProduct scrapes google shopping via Oxylabs E-Commerce API
@@ -323,6 +282,15 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProduct(
 	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
 }
 
+// BuildGoogleShoppingProductPayload returns the JSON payload that ScrapeGoogleShoppingProduct would send
+// for the given query and options, without sending it.
+func BuildGoogleShoppingProductPayload(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleShoppingProduct, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleShoppingProductCtx scrapes google shopping via Oxylabs E-Commerce API
 // with google_shopping_product as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
@@ -331,98 +299,83 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleShoppingProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingProduct,
-		"query":            query,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingProduct, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeGoogleShoppingProductParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source
+// and returns the parsed content of the results as GoogleShoppingProductContent.
+func (c *EcommerceClient) ScrapeGoogleShoppingProductParsed(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*response.Resp[GoogleShoppingProductContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeGoogleShoppingProductParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingProductParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source
+// and returns the parsed content of the results as GoogleShoppingProductContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingProductParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*response.Resp[GoogleShoppingProductContent], error) {
+	return scrapeParsed[GoogleShoppingProductContent](ctx, c, oxylabs.GoogleShoppingProduct, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// googleShoppingPricingSource describes the google_shopping_pricing source.
+var googleShoppingPricingSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleShoppingPricing,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "results_language", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // GoogleShoppingPricingOpts contains all the query parameters available for google shopping pricing.
 type GoogleShoppingPricingOpts struct {
-	StartPage         int
-	Pages             int
-	Locale            oxylabs.Locale
-	ResultsLanguage   string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackURL       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleShoppingPricing parameters.
-func (opt *GoogleShoppingPricingOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
+	StartPage           int
+	Pages               int
+	Locale              oxylabs.Locale
+	ResultsLanguage     string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackURL         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// params returns the GoogleShoppingPricingOpts as request parameters.
+func (opt *GoogleShoppingPricingOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"locale":               opt.Locale,
+		"results_language":     opt.ResultsLanguage,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackURL,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	return nil
 }
 
 // ScrapeGoogleShoppingPricing scrapes google shopping via Oxylabs E-Commerce API
@@ -437,6 +390,15 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricing(
 	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
 }
 
+// BuildGoogleShoppingPricingPayload returns the JSON payload that ScrapeGoogleShoppingPricing would send
+// for the given query and options, without sending it.
+func BuildGoogleShoppingPricingPayload(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleShoppingPricing, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleShoppingPricingCtx scrapes google shopping via Oxylabs E-Commerce API
 // with google_shopping_pricing as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
@@ -445,62 +407,30 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleShoppingPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingPricing,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingPricing, query, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingPricingParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source
+// and returns the parsed content of the results as GoogleShoppingPricingContent.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricingParsed(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*response.Resp[GoogleShoppingPricingContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeGoogleShoppingPricingParsedCtx(ctx, query, opts...)
+}
 
-	return resp, nil
+// ScrapeGoogleShoppingPricingParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source
+// and returns the parsed content of the results as GoogleShoppingPricingContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricingParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*response.Resp[GoogleShoppingPricingContent], error) {
+	return scrapeParsed[GoogleShoppingPricingContent](ctx, c, oxylabs.GoogleShoppingPricing, query, internal.LastOpt(opts).params())
 }
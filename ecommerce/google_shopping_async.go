@@ -2,12 +2,10 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // ScrapeGoogleShoppingUrl scrapes google shopping with async polling runtime
@@ -30,90 +28,7 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrlCtx(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "shopping.google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleShoppingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleShoppingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleShoppingUrl, url, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleShoppingSearch scrapes google shopping with async polling runtime
@@ -136,115 +51,32 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchCtx(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultSortBy(context)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingSearch,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "sort_by",
-				"value": context["sort_by"],
-			},
-			{
-				"key":   "min_price",
-				"value": context["min_price"],
-			},
-			{
-				"key":   "max_price",
-				"value": context["max_price"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingSearchParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source
+// and returns the parsed content of the results as GoogleShoppingSearchContent.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchParsed(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (chan *response.Resp[GoogleShoppingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.ScrapeGoogleShoppingSearchParsedCtx(ctx, query, opts...)
+}
 
-	return respChan, nil
+// ScrapeGoogleShoppingSearchParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source
+// and returns the parsed content of the results as GoogleShoppingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (chan *response.Resp[GoogleShoppingSearchContent], error) {
+	return scrapeParsedAsync[GoogleShoppingSearchContent](ctx, c, oxylabs.GoogleShoppingSearch, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleShoppingProduct scrapes google shopping with async polling runtime
@@ -267,85 +99,32 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductCtx(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingProduct,
-		"query":            query,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingProduct, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingProductParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source
+// and returns the parsed content of the results as GoogleShoppingProductContent.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductParsed(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (chan *response.Resp[GoogleShoppingProductContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.ScrapeGoogleShoppingProductParsedCtx(ctx, query, opts...)
+}
 
-	return respChan, nil
+// ScrapeGoogleShoppingProductParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source
+// and returns the parsed content of the results as GoogleShoppingProductContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (chan *response.Resp[GoogleShoppingProductContent], error) {
+	return scrapeParsedAsync[GoogleShoppingProductContent](ctx, c, oxylabs.GoogleShoppingProduct, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleShoppingPricing scrapes google shopping with async polling runtime
@@ -368,88 +147,30 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricingCtx(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingPricing,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleShoppingPricing, query, internal.LastOpt(opts).params())
+}
 
-	/// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleShoppingPricingParsed scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source
+// and returns the parsed content of the results as GoogleShoppingPricingContent.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricingParsed(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (chan *response.Resp[GoogleShoppingPricingContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.ScrapeGoogleShoppingPricingParsedCtx(ctx, query, opts...)
+}
 
-	return respChan, nil
+// ScrapeGoogleShoppingPricingParsedCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source
+// and returns the parsed content of the results as GoogleShoppingPricingContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricingParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (chan *response.Resp[GoogleShoppingPricingContent], error) {
+	return scrapeParsedAsync[GoogleShoppingPricingContent](ctx, c, oxylabs.GoogleShoppingPricing, query, internal.LastOpt(opts).params())
 }
@@ -0,0 +1,118 @@
+package ecommerce
+
+import (
+	"sort"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// Product is a product listing of a search page, either organic or paid,
+// with the fields shared by both. Organic or Paid is set to the listing
+// it comes from.
+type Product struct {
+	Url          string
+	Asin         string
+	Title        string
+	Price        oxylabs.Price
+	PriceUpper   oxylabs.Price
+	Currency     string
+	Rating       oxylabs.Float
+	ReviewsCount oxylabs.Int
+	IsPrime      bool
+	IsSponsored  bool
+
+	Organic *Organic
+	Paid    *Paid
+}
+
+// AllOrganic returns the organic results of every page of the response,
+// with their absolute position across the pages and without the results
+// whose URL already appeared on a previous page.
+func (r *Resp) AllOrganic() []response.Ranked[Organic] {
+	return response.Flatten(&r.Resp,
+		func(c Content) []Organic { return c.Results.Organic },
+		func(o Organic) string { return o.Url },
+	)
+}
+
+// AllPaid returns the paid results of every page of the response, ranked
+// and deduplicated like AllOrganic.
+func (r *Resp) AllPaid() []response.Ranked[Paid] {
+	return response.Flatten(&r.Resp,
+		func(c Content) []Paid { return c.Results.Paid },
+		func(p Paid) string { return p.Url },
+	)
+}
+
+// AllProducts returns the organic and paid product listings of every page
+// of the response, ranked and deduplicated like AllOrganic. The listings of
+// a page are ordered by their overall position if they all have one, and
+// the organic listings come before the paid ones otherwise.
+func (r *Resp) AllProducts() []response.Ranked[Product] {
+	return response.Flatten(&r.Resp, pageProducts, func(p Product) string { return p.Url })
+}
+
+// pageProducts returns the product listings of a page, see AllProducts.
+func pageProducts(c Content) []Product {
+	products := []Product{}
+	positions := []oxylabs.Int{}
+	for i := range c.Results.Organic {
+		o := &c.Results.Organic[i]
+		products = append(products, Product{
+			Url:          o.Url,
+			Asin:         o.Asin,
+			Title:        o.Title,
+			Price:        o.Price,
+			PriceUpper:   o.PriceUpper,
+			Currency:     o.Currency,
+			Rating:       o.Rating,
+			ReviewsCount: o.ReviewsCount,
+			IsPrime:      o.IsPrime,
+			IsSponsored:  o.IsSponsored,
+			Organic:      o,
+		})
+		positions = append(positions, o.PosOVerall)
+	}
+	for i := range c.Results.Paid {
+		p := &c.Results.Paid[i]
+		products = append(products, Product{
+			Url:          p.Url,
+			Asin:         p.Asin,
+			Title:        p.Title,
+			Price:        p.Price,
+			PriceUpper:   p.PriceUpper,
+			Currency:     p.Currency,
+			Rating:       p.Rating,
+			ReviewsCount: p.ReviewsCount,
+			IsPrime:      p.IsPrime,
+			IsSponsored:  true,
+			Paid:         p,
+		})
+		positions = append(positions, p.PosOverall)
+	}
+
+	for _, pos := range positions {
+		if !pos.Valid {
+			return products
+		}
+	}
+	sort.Stable(byPosition{products, positions})
+
+	return products
+}
+
+// byPosition sorts products by their overall position.
+type byPosition struct {
+	products  []Product
+	positions []oxylabs.Int
+}
+
+func (s byPosition) Len() int { return len(s.products) }
+
+func (s byPosition) Less(i, j int) bool { return s.positions[i].Value < s.positions[j].Value }
+
+func (s byPosition) Swap(i, j int) {
+	s.products[i], s.products[j] = s.products[j], s.products[i]
+	s.positions[i], s.positions[j] = s.positions[j], s.positions[i]
+}
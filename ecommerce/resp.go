@@ -2,38 +2,29 @@ package ecommerce
 
 import (
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
-// Resp is the response struct for all ecommerce sources.
+// Resp is the response struct for all ecommerce sources. Its envelope and its
+// decoding are shared with the serp package by response.Resp.
 type Resp struct {
-	Parse             bool      `json:"parse"`
-	ParseInstructions bool      `json:"parse_instructions"`
-	Results           []Results `json:"results"`
-	Job               Job       `json:"job"`
-	StatusCode        int       `json:"status_code"`
-	Status            string    `json:"status"`
-}
-
-type Results struct {
-	CustomContentParsed map[string]interface{}
-	ContentParsed       Content
-	Content             string
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
-	Page                int    `json:"page"`
-	Url                 string `json:"url"`
-	JobID               string `json:"job_id"`
-	StatusCode          int    `json:"status_code"`
-	ParserType          string `json:"parser_type"`
+	response.Resp[Content]
 }
 
+// Results is the result of a page of a ecommerce response.
+type Results = response.Results[Content]
+
+// Content contains the parsed content of every ecommerce source. The typed
+// responses returned by the Scrape*Parsed methods, e.g. AmazonProductContent,
+// only contain the fields of their source.
 type Content struct {
 	Url                    string                         `json:"url"`
 	Title                  string                         `json:"title"`
-	Pages                  int                            `json:"pages"`
+	Pages                  oxylabs.Int                    `json:"pages"`
 	Query                  string                         `json:"query"`
 	Images                 interface{}                    `json:"images"`
 	Variants               Variants                       `json:"variants"`
@@ -41,63 +32,66 @@ type Content struct {
 	Description            string                         `json:"description"`
 	RelatedItems           RelatedItems                   `json:"related_items"`
 	Specifications         Specifications                 `json:"specifications"`
-	Page                   int                            `json:"page"`
+	Page                   oxylabs.Int                    `json:"page"`
 	Errors                 interface{}                    `json:"_errors"`
 	Results                Result                         `json:"results"`
-	Rating                 float64                        `json:"rating"`
+	Rating                 oxylabs.Float                  `json:"rating"`
 	Pricing                []Pricing                      `json:"pricing"`
 	Ads                    []AmazonProductAds             `json:"ads"`
 	Asin                   string                         `json:"asin"`
-	Price                  float64                        `json:"price"`
+	Price                  oxylabs.Price                  `json:"price"`
 	Stock                  string                         `json:"stock"`
 	Coupon                 string                         `json:"coupon"`
 	Category               []AmazonProductCategory        `json:"category"`
 	Currency               string                         `json:"currency"`
 	Delivery               []AmazonProductDelivery        `json:"delivery"`
-	Warnings               []string                       `json:"_warnings,omitempty"`
+	Warnings               []string                       `json:"_warnings,omitempty" oxy:"optional"`
 	DealType               string                         `json:"deal_type"`
 	PageType               string                         `json:"page_type"`
-	PriceSns               int                            `json:"price_sns"`
+	PriceSns               oxylabs.Price                  `json:"price_sns"`
 	Variation              interface{}                    `json:"variation"`
 	HasVideos              bool                           `json:"has_videos"`
 	SalesRank              []AmazonProductSalesRank       `json:"sales_rank"`
 	TopReview              string                         `json:"top_review"`
 	AsinInUrl              string                         `json:"asin_in_url"`
-	PriceUpper             float64                        `json:"price_upper"`
+	PriceUpper             oxylabs.Price                  `json:"price_upper"`
 	PricingStr             string                         `json:"pricing_str"`
 	PricingURL             string                         `json:"pricing_url"`
 	DiscountEnd            string                         `json:"discount_end"`
 	Manufacturer           string                         `json:"manufacturer"`
-	MaxQuantity            int                            `json:"max_quantity"`
-	PriceBuybox            float64                        `json:"price_buybox"`
+	MaxQuantity            oxylabs.Int                    `json:"max_quantity"`
+	PriceBuybox            oxylabs.Price                  `json:"price_buybox"`
 	ProductName            string                         `json:"product_name"`
 	BulletPoints           string                         `json:"bullet_points"`
 	IsAddonItem            bool                           `json:"is_addon_item"`
-	PriceInitial           int                            `json:"price_initial"`
-	PricingCount           int                            `json:"pricing_count"`
-	ReviewsCount           int                            `json:"reviews_count"`
+	PriceInitial           oxylabs.Price                  `json:"price_initial"`
+	PricingCount           oxylabs.Int                    `json:"pricing_count"`
+	ReviewsCount           oxylabs.Int                    `json:"reviews_count"`
 	SNSDiscounts           []interface{}                  `json:"sns_discounts"`
 	DeveloperInfo          []interface{}                  `json:"developer_info"`
 	LightningDeal          interface{}                    `json:"lightning_deal"`
-	PriceShipping          int                            `json:"price_shipping"`
+	PriceShipping          oxylabs.Price                  `json:"price_shipping"`
 	IsPrimePantry          bool                           `json:"is_prime_pantry"`
 	ProductDetails         ProductDetails                 `json:"product_details"`
 	FeaturedMerchant       []interface{}                  `json:"featured_merchant"`
 	IsPrimeEligible        bool                           `json:"is_prime_eligible"`
 	ProductDimensions      string                         `json:"product_dimensions"`
 	RefurbishedProduct     AmazonRefurbishedProduct       `json:"refurbished_product"`
-	AnsweredQuestionsCount int                            `json:"answered_questions_count"`
+	AnsweredQuestionsCount oxylabs.Int                    `json:"answered_questions_count"`
 	RatingStarDistribution []AmazonRatingStarDistribution `json:"rating_star_distribution"`
 	Reviews                []AmazonReviews                `json:"reviews"`
 	Questions              AmazonQuestions                `json:"questions"`
-	QuestionsTotal         int                            `json:"questions_total"`
+	QuestionsTotal         oxylabs.Int                    `json:"questions_total"`
 	BusinessName           string                         `json:"business_name"`
 	RecentFeedback         []RecentFeedback               `json:"recent_feedback"`
 	BusinessAddress        string                         `json:"business_address"`
 	FeedbackSummaryTable   FeedbackSummaryTable           `json:"feedback_summary_table"`
-	ReviewCount            int                            `json:"review_count"`
-	LastVisiblePage        int                            `json:"last_visible_page"`
-	ParseStatusCode        int                            `json:"parse_status_code"`
+	ReviewCount            oxylabs.Int                    `json:"review_count"`
+	LastVisiblePage        oxylabs.Int                    `json:"last_visible_page"`
+	ParseStatusCode        oxylabs.Int                    `json:"parse_status_code"`
+
+	// Extra contains the fields of the content that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type Result struct {
@@ -108,21 +102,24 @@ type Result struct {
 	Suggested              []SuggestedAmazonSearch  `json:"suggested"`
 	AmazonChoices          []AmazonChoices          `json:"amazon_choices"`
 	InstantRecommendations []InstantRecommendations `json:"instant_recommendations"`
-	Pos                    int                      `json:"pos"`
+	Pos                    oxylabs.Int              `json:"pos"`
 	Url                    string                   `json:"url"`
 	Asin                   string                   `json:"asin"`
-	Price                  float64                  `json:"price"`
+	Price                  oxylabs.Price            `json:"price"`
 	Title                  string                   `json:"title"`
-	Rating                 float64                  `json:"rating"`
+	Rating                 oxylabs.Float            `json:"rating"`
 	Currency               string                   `json:"currency"`
 	IsPrime                bool                     `json:"is_prime"`
-	PriceStr               string                   `json:"price_str"`
-	PriceUpper             float64                  `json:"price_upper"`
-	RatingsCount           int                      `json:"ratings_count"`
+	PriceStr               oxylabs.PriceText        `json:"price_str"`
+	PriceUpper             oxylabs.Price            `json:"price_upper"`
+	RatingsCount           oxylabs.Int              `json:"ratings_count"`
+
+	// Extra contains the results that are not mapped, e.g. new result types.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type Paid struct {
-	Pos                 int           `json:"pos"`
+	Pos                 oxylabs.Int   `json:"pos"`
 	Url                 string        `json:"url"`
 	Desc                string        `json:"desc"`
 	Title               string        `json:"title"`
@@ -131,28 +128,28 @@ type Paid struct {
 	Sitelinks           PaidSitelinks `json:"sitelinks"`
 	UrlShown            string        `json:"url_shown"`
 	Asin                string        `json:"asin"`
-	Price               float64       `json:"price"`
-	Rating              float64       `json:"rating"`
-	RelPos              int           `json:"rel_pos"`
+	Price               oxylabs.Price `json:"price"`
+	Rating              oxylabs.Float `json:"rating"`
+	RelPos              oxylabs.Int   `json:"rel_pos"`
 	Currency            string        `json:"currency"`
 	UrlImage            string        `json:"url_image"`
 	BestSeller          bool          `json:"best_seller"`
-	PriceUpper          float64       `json:"price_upper"`
+	PriceUpper          oxylabs.Price `json:"price_upper"`
 	IsSponsored         bool          `json:"is_sponsored"`
 	Manufacturer        string        `json:"manufacturer"`
-	PricingCount        int           `json:"pricing_count"`
-	ReviewsCount        int           `json:"reviews_count"`
+	PricingCount        oxylabs.Int   `json:"pricing_count"`
+	ReviewsCount        oxylabs.Int   `json:"reviews_count"`
 	IsAmazonsChoice     bool          `json:"is_amazons_choice"`
 	NoPriceReason       string        `json:"no_price_reason"`
 	SalesVolume         string        `json:"sales_volume"`
 	IsPrime             bool          `json:"is_prime"`
 	ShippingInformation string        `json:"shipping_information"`
-	PosOverall          int           `json:"pos_overall"`
+	PosOverall          oxylabs.Int   `json:"pos_overall"`
 }
 
 type PaidSitelinks struct {
-	Expanded []Expanded `json:"expanded,omitempty"`
-	Inline   []Inline   `json:"inline,omitempty"`
+	Expanded []Expanded `json:"expanded,omitempty" oxy:"optional"`
+	Inline   []Inline   `json:"inline,omitempty" oxy:"optional"`
 }
 
 type Expanded struct {
@@ -176,41 +173,41 @@ type Filters struct {
 }
 
 type Organic struct {
-	Pos      int     `json:"pos"`
-	Url      string  `json:"url"`
-	Type     string  `json:"type"`
-	Price    float64 `json:"price"`
-	Title    string  `json:"title"`
-	Currency string  `json:"currency"`
+	Pos      oxylabs.Int   `json:"pos"`
+	Url      string        `json:"url"`
+	Type     string        `json:"type"`
+	Price    oxylabs.Price `json:"price"`
+	Title    string        `json:"title"`
+	Currency string        `json:"currency"`
 	Merchant struct {
 		Url  string `json:"url"`
 		Name string `json:"name"`
 	} `json:"merchant"`
-	PriceStr        string       `json:"price_str"`
-	ProductId       string       `json:"product_id"`
-	Asin            string       `json:"asin"`
-	Rating          float64      `json:"rating"`
-	UrlImage        string       `json:"url_image"`
-	BestSeller      bool         `json:"best_seller"`
-	PriceUpper      float64      `json:"price_upper"`
-	IsSponsored     bool         `json:"is_sponsored"`
-	Manufacturer    string       `json:"manufacturer"`
-	PricingCount    int          `json:"pricing_count"`
-	ReviewsCount    int          `json:"reviews_count"`
-	IsAmazonsChoice bool         `json:"is_amazons_choice"`
-	NoPriceReason   string       `json:"no_price_reason"`
-	IsPrime         bool         `json:"is_prime"`
-	SalesVolume     string       `json:"sales_volume"`
-	Variations      []Variations `json:"variations"`
-	PosOVerall      int          `json:"pos_overall"`
+	PriceStr        oxylabs.PriceText `json:"price_str"`
+	ProductId       string            `json:"product_id"`
+	Asin            string            `json:"asin"`
+	Rating          oxylabs.Float     `json:"rating"`
+	UrlImage        string            `json:"url_image"`
+	BestSeller      bool              `json:"best_seller"`
+	PriceUpper      oxylabs.Price     `json:"price_upper"`
+	IsSponsored     bool              `json:"is_sponsored"`
+	Manufacturer    string            `json:"manufacturer"`
+	PricingCount    oxylabs.Int       `json:"pricing_count"`
+	ReviewsCount    oxylabs.Int       `json:"reviews_count"`
+	IsAmazonsChoice bool              `json:"is_amazons_choice"`
+	NoPriceReason   string            `json:"no_price_reason"`
+	IsPrime         bool              `json:"is_prime"`
+	SalesVolume     string            `json:"sales_volume"`
+	Variations      []Variations      `json:"variations"`
+	PosOVerall      oxylabs.Int       `json:"pos_overall"`
 }
 
 type Variations struct {
-	Asin               string  `json:"asin"`
-	Title              string  `json:"title"`
-	Price              float64 `json:"price"`
-	PriceStrikethrough float64 `json:"price_strikethrough"`
-	NotAvailable       bool    `json:"not_available"`
+	Asin               string        `json:"asin"`
+	Title              string        `json:"title"`
+	Price              oxylabs.Price `json:"price"`
+	PriceStrikethrough oxylabs.Price `json:"price_strikethrough"`
+	NotAvailable       bool          `json:"not_available"`
 }
 
 type SearchInformation struct {
@@ -230,12 +227,12 @@ type Variants struct {
 
 type RelatedItems struct {
 	Items []struct {
-		Url          string  `json:"url"`
-		Price        float64 `json:"price"`
-		Title        string  `json:"title"`
-		Rating       float64 `json:"rating"`
-		Currency     string  `json:"currency"`
-		ReviewsCount int     `json:"reviews_count"`
+		Url          string        `json:"url"`
+		Price        oxylabs.Price `json:"price"`
+		Title        string        `json:"title"`
+		Rating       oxylabs.Float `json:"rating"`
+		Currency     string        `json:"currency"`
+		ReviewsCount oxylabs.Int   `json:"reviews_count"`
 	} `json:"items"`
 }
 
@@ -248,99 +245,99 @@ type Specifications struct {
 }
 
 type Pricing struct {
-	Price         float64 `json:"price"`
-	Seller        string  `json:"seller"`
-	Details       string  `json:"details"`
-	Currency      string  `json:"currency"`
-	Condition     string  `json:"condition"`
-	PriceTax      float64 `json:"price_tax"`
-	PriceTotal    float64 `json:"price_total"`
-	SellerLink    string  `json:"seller_link"`
-	PriceShipping float64 `json:"price_shipping"`
+	Price         oxylabs.Price `json:"price"`
+	Seller        string        `json:"seller"`
+	Details       string        `json:"details"`
+	Currency      string        `json:"currency"`
+	Condition     string        `json:"condition"`
+	PriceTax      oxylabs.Price `json:"price_tax"`
+	PriceTotal    oxylabs.Price `json:"price_total"`
+	SellerLink    string        `json:"seller_link"`
+	PriceShipping oxylabs.Price `json:"price_shipping"`
 
 	Delivery        string      `json:"delivery"`
 	SellerId        string      `json:"seller_id"`
-	RatingCount     int         `json:"rating_count"`
+	RatingCount     oxylabs.Int `json:"rating_count"`
 	DeliveryOptions interface{} `json:"delivery_options"`
 }
 
 type SuggestedAmazonSearch struct {
-	Url                 string  `json:"url"`
-	Asin                string  `json:"asin"`
-	Price               float64 `json:"price"`
-	Title               string  `json:"title"`
-	Rating              float64 `json:"rating"`
-	Currency            string  `json:"currency"`
-	UrlImage            string  `json:"url_image"`
-	BestSeller          bool    `json:"best_seller"`
-	PriceUpper          float64 `json:"price_upper"`
-	IsSponsored         bool    `json:"is_sponsored"`
-	Manufacturer        string  `json:"manufacturer"`
-	PricingCount        int     `json:"pricing_count"`
-	ReviewsCount        int     `json:"reviews_count"`
-	IsAmazonsChoice     bool    `json:"is_amazons_choice"`
-	Pos                 int     `json:"pos"`
-	ShippingInformation string  `json:"shipping_information"`
-	SalesVolume         string  `json:"sales_volume"`
-	NoPriceReason       string  `json:"no_price_reason"`
-	SuggestedQuery      string  `json:"suggested_query"`
+	Url                 string        `json:"url"`
+	Asin                string        `json:"asin"`
+	Price               oxylabs.Price `json:"price"`
+	Title               string        `json:"title"`
+	Rating              oxylabs.Float `json:"rating"`
+	Currency            string        `json:"currency"`
+	UrlImage            string        `json:"url_image"`
+	BestSeller          bool          `json:"best_seller"`
+	PriceUpper          oxylabs.Price `json:"price_upper"`
+	IsSponsored         bool          `json:"is_sponsored"`
+	Manufacturer        string        `json:"manufacturer"`
+	PricingCount        oxylabs.Int   `json:"pricing_count"`
+	ReviewsCount        oxylabs.Int   `json:"reviews_count"`
+	IsAmazonsChoice     bool          `json:"is_amazons_choice"`
+	Pos                 oxylabs.Int   `json:"pos"`
+	ShippingInformation string        `json:"shipping_information"`
+	SalesVolume         string        `json:"sales_volume"`
+	NoPriceReason       string        `json:"no_price_reason"`
+	SuggestedQuery      string        `json:"suggested_query"`
 }
 
 type AmazonChoices struct {
-	Url                 string       `json:"url"`
-	Asin                string       `json:"asin"`
-	Price               float64      `json:"price"`
-	Title               string       `json:"title"`
-	Rating              float64      `json:"rating"`
-	Currency            string       `json:"currency"`
-	UrlImage            string       `json:"url_image"`
-	BestSeller          bool         `json:"best_seller"`
-	PriceUpper          float64      `json:"price_upper"`
-	IsSponsored         bool         `json:"is_sponsored"`
-	Manufacturer        string       `json:"manufacturer"`
-	PricingCount        int          `json:"pricing_count"`
-	ReviewsCount        int          `json:"reviews_count"`
-	IsAmazonsChoice     bool         `json:"is_amazons_choice"`
-	Pos                 int          `json:"pos"`
-	IsPrime             bool         `json:"is_prime"`
-	ShippingInformation string       `json:"shipping_information"`
-	SalesVolume         string       `json:"sales_volume"`
-	NoPriceReason       string       `json:"no_price_reason"`
-	Variations          []Variations `json:"variations"`
+	Url                 string        `json:"url"`
+	Asin                string        `json:"asin"`
+	Price               oxylabs.Price `json:"price"`
+	Title               string        `json:"title"`
+	Rating              oxylabs.Float `json:"rating"`
+	Currency            string        `json:"currency"`
+	UrlImage            string        `json:"url_image"`
+	BestSeller          bool          `json:"best_seller"`
+	PriceUpper          oxylabs.Price `json:"price_upper"`
+	IsSponsored         bool          `json:"is_sponsored"`
+	Manufacturer        string        `json:"manufacturer"`
+	PricingCount        oxylabs.Int   `json:"pricing_count"`
+	ReviewsCount        oxylabs.Int   `json:"reviews_count"`
+	IsAmazonsChoice     bool          `json:"is_amazons_choice"`
+	Pos                 oxylabs.Int   `json:"pos"`
+	IsPrime             bool          `json:"is_prime"`
+	ShippingInformation string        `json:"shipping_information"`
+	SalesVolume         string        `json:"sales_volume"`
+	NoPriceReason       string        `json:"no_price_reason"`
+	Variations          []Variations  `json:"variations"`
 }
 
 type InstantRecommendations struct {
-	Url             string  `json:"url"`
-	Asin            string  `json:"asin"`
-	Price           float64 `json:"price"`
-	Title           string  `json:"title"`
-	Rating          float64 `json:"rating"`
-	Currency        string  `json:"currency"`
-	UrlImage        string  `json:"url_image"`
-	BestSeller      bool    `json:"best_seller"`
-	PriceUpper      float64 `json:"price_upper"`
-	IsSponsored     bool    `json:"is_sponsored"`
-	Manufacturer    string  `json:"manufacturer"`
-	PricingCount    int     `json:"pricing_count"`
-	ReviewsCount    int     `json:"reviews_count"`
-	IsAmazonsChoice bool    `json:"is_amazons_choice"`
-	Pos             int     `json:"pos"`
-	SalesVolume     string  `json:"sales_volume"`
-	NoPriceReason   string  `json:"no_price_reason"`
+	Url             string        `json:"url"`
+	Asin            string        `json:"asin"`
+	Price           oxylabs.Price `json:"price"`
+	Title           string        `json:"title"`
+	Rating          oxylabs.Float `json:"rating"`
+	Currency        string        `json:"currency"`
+	UrlImage        string        `json:"url_image"`
+	BestSeller      bool          `json:"best_seller"`
+	PriceUpper      oxylabs.Price `json:"price_upper"`
+	IsSponsored     bool          `json:"is_sponsored"`
+	Manufacturer    string        `json:"manufacturer"`
+	PricingCount    oxylabs.Int   `json:"pricing_count"`
+	ReviewsCount    oxylabs.Int   `json:"reviews_count"`
+	IsAmazonsChoice bool          `json:"is_amazons_choice"`
+	Pos             oxylabs.Int   `json:"pos"`
+	SalesVolume     string        `json:"sales_volume"`
+	NoPriceReason   string        `json:"no_price_reason"`
 }
 
 type AmazonProductAds struct {
-	Pos             int      `json:"pos"`
-	Asin            string   `json:"asin"`
-	Type            string   `json:"type"`
-	Price           float64  `json:"price"`
-	Title           string   `json:"title"`
-	Images          []string `json:"images"`
-	Rating          float64  `json:"rating"`
-	Location        string   `json:"location"`
-	PriceUpper      float64  `json:"price_upper"`
-	ReviewsCount    int      `json:"reviews_count"`
-	IsPrimeEligible bool     `json:"is_prime_eligible"`
+	Pos             oxylabs.Int   `json:"pos"`
+	Asin            string        `json:"asin"`
+	Type            string        `json:"type"`
+	Price           oxylabs.Price `json:"price"`
+	Title           string        `json:"title"`
+	Images          []string      `json:"images"`
+	Rating          oxylabs.Float `json:"rating"`
+	Location        string        `json:"location"`
+	PriceUpper      oxylabs.Price `json:"price_upper"`
+	ReviewsCount    oxylabs.Int   `json:"reviews_count"`
+	IsPrimeEligible bool          `json:"is_prime_eligible"`
 }
 
 type AmazonProductCategory struct {
@@ -359,7 +356,7 @@ type AmazonProductDelivery struct {
 }
 
 type AmazonProductSalesRank struct {
-	Rank   int `json:"rank"`
+	Rank   oxylabs.Int `json:"rank"`
 	Ladder []struct {
 		Url  string `json:"url"`
 		Name string `json:"name"`
@@ -389,24 +386,24 @@ type AmazonRefurbishedProduct struct {
 }
 
 type AmazonRatingStarDistribution struct {
-	Rating     float64 `json:"rating"`
-	Percentage int     `json:"percentage"`
+	Rating     oxylabs.Float `json:"rating"`
+	Percentage oxylabs.Int   `json:"percentage"`
 }
 
 type AmazonReviews struct {
-	Id                string  `json:"id"`
-	Title             string  `json:"title"`
-	Author            string  `json:"author"`
-	Rating            float64 `json:"rating"`
-	Content           string  `json:"content"`
-	Timestamp         string  `json:"timestamp"`
-	IsVerified        bool    `json:"is_verified"`
-	ProductAttributes string  `json:"product_attributes"`
+	Id                string        `json:"id"`
+	Title             string        `json:"title"`
+	Author            string        `json:"author"`
+	Rating            oxylabs.Float `json:"rating"`
+	Content           string        `json:"content"`
+	Timestamp         string        `json:"timestamp"`
+	IsVerified        bool          `json:"is_verified"`
+	ProductAttributes string        `json:"product_attributes"`
 }
 
 type AmazonQuestions struct {
-	Title   string `json:"title"`
-	Votes   int    `json:"votes"`
+	Title   string      `json:"title"`
+	Votes   oxylabs.Int `json:"votes"`
 	Answers []struct {
 		Author    string `json:"author"`
 		Content   string `json:"content"`
@@ -415,17 +412,17 @@ type AmazonQuestions struct {
 }
 
 type RecentFeedback struct {
-	Feedback    string `json:"feedback"`
-	RatedBy     string `json:"rated_by"`
-	RatingStars int    `json:"rating_stars"`
+	Feedback    string      `json:"feedback"`
+	RatedBy     string      `json:"rated_by"`
+	RatingStars oxylabs.Int `json:"rating_stars"`
 }
 
 type FeedbackSummaryTable struct {
 	Counts struct {
-		ThirtyDays   int `json:"30_days"`
-		NinetyDays   int `json:"90_days"`
-		AllTime      int `json:"all_time"`
-		TwelveMonths int `json:"12_months"`
+		ThirtyDays   oxylabs.Int `json:"30_days"`
+		NinetyDays   oxylabs.Int `json:"90_days"`
+		AllTime      oxylabs.Int `json:"all_time"`
+		TwelveMonths oxylabs.Int `json:"12_months"`
 	} `json:"counts"`
 	Neutral struct {
 		ThirtyDays   string `json:"30_days"`
@@ -447,145 +444,19 @@ type FeedbackSummaryTable struct {
 	} `json:"positive"`
 }
 
-type Job struct {
-	CallbackUrl string `json:"callback_url"`
-	ClientID    int    `json:"client_id"`
-	Context     []struct {
-		Key   string      `json:"key"`
-		Value interface{} `json:"value"`
-	} `json:"context,omitempty"`
-	CreatedAt           string        `json:"created_at"`
-	Domain              string        `json:"domain"`
-	GeoLocation         interface{}   `json:"geo_location"`
-	ID                  string        `json:"id"`
-	Limit               int           `json:"limit"`
-	Locale              interface{}   `json:"locale"`
-	Pages               int           `json:"pages"`
-	Parse               bool          `json:"parse"`
-	ParserType          interface{}   `json:"parser_type"`
-	ParsingInstructions interface{}   `json:"parsing_instructions"`
-	BrowserInstructions interface{}   `json:"browser_instructions"`
-	Render              interface{}   `json:"render"`
-	Url                 interface{}   `json:"url"`
-	Query               string        `json:"query"`
-	Source              string        `json:"source"`
-	StartPage           int           `json:"start_page"`
-	Status              string        `json:"status"`
-	StorageType         interface{}   `json:"storage_type"`
-	StorageUrl          interface{}   `json:"storage_url"`
-	Subdomain           string        `json:"subdomain"`
-	ContentEncoding     string        `json:"content_encoding"`
-	UpdatedAt           string        `json:"updated_at"`
-	UserAgentType       string        `json:"user_agent_type"`
-	SessionInfo         interface{}   `json:"session_info"`
-	Statuses            []interface{} `json:"statuses"`
-	ClientNotes         interface{}   `json:"client_notes"`
-	Links               []struct {
-		Rel    string `json:"rel"`
-		Href   string `json:"href"`
-		Method string `json:"method"`
-	} `json:"_links,omitempty"`
-}
-
-// Custom function to unmarshal into the Resp struct.
-// Because of different return types depending on the parse option.
-func (r *Resp) UnmarshalJSON(data []byte) error {
-	// Unmarshal json data into RawResp map.
-	var rawResp map[string]json.RawMessage
-	if err := json.Unmarshal(data, &rawResp); err != nil {
-		return err
-	}
+// Job is the job of a response, shared by every source.
+type Job = oxylabs.Job
 
-	// Unmarshal the results array.
-	if resultsData, ok := rawResp["results"]; ok {
-		// Slice to store raw JSON messages for each result.
-		var resultsRawMessages []json.RawMessage
-		if err := json.Unmarshal(resultsData, &resultsRawMessages); err != nil {
-			return err
-		}
-
-		// Unmarshal each result into the Results slice.
-		for _, resultRawMessage := range resultsRawMessages {
-			if r.Parse && !r.ParseInstructions {
-				var result struct {
-					ContentParsed Content `json:"content"`
-					CreatedAt     string  `json:"created_at"`
-					UpdatedAt     string  `json:"updated_at"`
-					Page          int     `json:"page"`
-					Url           string  `json:"url"`
-					JobID         string  `json:"job_id"`
-					StatusCode    int     `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					ContentParsed: result.ContentParsed,
-					CreatedAt:     result.CreatedAt,
-					UpdatedAt:     result.UpdatedAt,
-					Page:          result.Page,
-					Url:           result.Url,
-					JobID:         result.JobID,
-					StatusCode:    result.StatusCode,
-				})
-			} else if r.Parse && r.ParseInstructions {
-				var result struct {
-					CustomContentParsed map[string]interface{} `json:"content"`
-					CreatedAt           string                 `json:"created_at"`
-					UpdatedAt           string                 `json:"updated_at"`
-					Page                int                    `json:"page"`
-					Url                 string                 `json:"url"`
-					JobID               string                 `json:"job_id"`
-					StatusCode          int                    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					CustomContentParsed: result.CustomContentParsed,
-					CreatedAt:           result.CreatedAt,
-					UpdatedAt:           result.UpdatedAt,
-					Page:                result.Page,
-					Url:                 result.Url,
-					JobID:               result.JobID,
-					StatusCode:          result.StatusCode,
-				})
-			} else if !r.Parse {
-				var result struct {
-					Content    string `json:"content"`
-					CreatedAt  string `json:"created_at"`
-					UpdatedAt  string `json:"updated_at"`
-					Page       int    `json:"page"`
-					Url        string `json:"url"`
-					JobID      string `json:"job_id"`
-					StatusCode int    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					Content:    result.Content,
-					CreatedAt:  result.CreatedAt,
-					UpdatedAt:  result.UpdatedAt,
-					Page:       result.Page,
-					Url:        result.Url,
-					JobID:      result.JobID,
-					StatusCode: result.StatusCode,
-				})
-			}
-		}
-	}
-
-	// Unmarshal the job object.
-	if jobData, ok := rawResp["job"]; ok {
-		var job Job
-		if err := json.Unmarshal(jobData, &job); err != nil {
-			return err
-		}
-		r.Job = job
-	}
+// UnmarshalJSON unmarshals the content and keeps the fields that are not mapped in Extra.
+func (c *Content) UnmarshalJSON(data []byte) error {
+	type content Content
+	return internal.UnmarshalExtra(data, (*content)(c), &c.Extra)
+}
 
-	return nil
+// UnmarshalJSON unmarshals the results and keeps the fields that are not mapped in Extra.
+func (r *Result) UnmarshalJSON(data []byte) error {
+	type result Result
+	return internal.UnmarshalExtra(data, (*result)(r), &r.Extra)
 }
 
 // GetResp returns a Resp struct from the http.Response object.
@@ -596,28 +467,16 @@ func GetResp(
 	parse bool,
 	customParserFlag bool,
 ) (*Resp, error) {
-	// Read the resp body into a buffer.
-	respBody, err := io.ReadAll(httpResp.Body)
+	res, err := response.GetResp[Content](httpResp, parse, customParserFlag)
 	if err != nil {
 		return nil, err
 	}
 
-	// If status code not 200, return error.
-	if httpResp.StatusCode != 200 {
-		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
-	}
-
-	// Unmarshal the JSON object.
-	res := &Resp{}
-	res.Parse = parse
-	res.ParseInstructions = customParserFlag
-	if err := res.UnmarshalJSON(respBody); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON object: %v", err)
-	}
-
-	// Set status code and status.
-	res.StatusCode = httpResp.StatusCode
-	res.Status = httpResp.Status
+	return &Resp{Resp: *res}, nil
+}
 
-	return res, nil
+// DecodeParsed decodes the CustomContentParsed of the results into the struct T,
+// whose parse instructions can be generated with oxylabs.ParseInstructionsFor.
+func DecodeParsed[T any](results Results) (T, error) {
+	return oxylabs.DecodeParsed[T](results.CustomContentParsed)
 }
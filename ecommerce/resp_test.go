@@ -0,0 +1,278 @@
+package ecommerce
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	pngenc "image/png"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func universalResp(t *testing.T, content string, headers string, encoding string) *Resp {
+	data := fmt.Sprintf(`{
+		"results": [{"content": %q, "headers": %s, "page": 1, "status_code": 200}],
+		"job": {"content_encoding": %q, "source": "universal_ecommerce"}
+	}`, content, headers, encoding)
+
+	resp := &Resp{}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(data)))
+	return resp
+}
+
+func TestResp_DecodesBase64Content(t *testing.T) {
+	html := "<html><head><meta charset=\"windows-1252\"></head><body>caf\xe9</body></html>"
+	resp := universalResp(t, base64.StdEncoding.EncodeToString([]byte(html)), `{}`, "base64")
+
+	result := resp.Results[0]
+	assert.Equal(t, []byte(html), result.RawContent)
+	assert.Equal(t, "text/html", result.ContentType)
+	assert.Equal(t, "windows-1252", result.Charset)
+	assert.Contains(t, result.Content, "café")
+}
+
+func TestResp_DecodesCharsetFromHeaders(t *testing.T) {
+	text := "\xa4100"
+	resp := universalResp(
+		t,
+		base64.StdEncoding.EncodeToString([]byte(text)),
+		`{"content-type": "text/plain; charset=iso-8859-15"}`,
+		"base64",
+	)
+
+	result := resp.Results[0]
+	assert.Equal(t, "text/plain", result.ContentType)
+	assert.Equal(t, "€100", result.Content)
+}
+
+func TestResp_KeepsBinaryContent(t *testing.T) {
+	pdf := "%PDF-1.4\n\x00\x01\x02binary"
+	encoded := base64.StdEncoding.EncodeToString([]byte(pdf))
+	resp := universalResp(t, encoded, `{"Content-Type": ["application/pdf"]}`, "base64")
+
+	result := resp.Results[0]
+	assert.Equal(t, []byte(pdf), result.RawContent)
+	assert.Equal(t, "application/pdf", result.ContentType)
+	assert.Equal(t, encoded, result.Content)
+}
+
+func TestResp_KeepsBinaryContentWithTextHeader(t *testing.T) {
+	pdf := "%PDF-1.4\n\x00\x01\x02binary"
+	encoded := base64.StdEncoding.EncodeToString([]byte(pdf))
+	resp := universalResp(t, encoded, `{"Content-Type": "text/html; charset=windows-1252"}`, "base64")
+
+	result := resp.Results[0]
+	assert.Equal(t, []byte(pdf), result.RawContent)
+	assert.Equal(t, "application/pdf", result.ContentType)
+	assert.Empty(t, result.Charset)
+	assert.Equal(t, encoded, result.Content)
+}
+
+func TestResp_KeepsContentOfUnknownEncoding(t *testing.T) {
+	resp := universalResp(t, "H4sIAAAAAAAA", `{}`, "gzip")
+
+	result := resp.Results[0]
+	assert.Equal(t, "H4sIAAAAAAAA", result.Content)
+	assert.Nil(t, result.RawContent)
+}
+
+func TestResp_ScreenshotOfBase64Content(t *testing.T) {
+	var png bytes.Buffer
+	assert.NoError(t, pngenc.Encode(&png, image.NewGray(image.Rect(0, 0, 2, 2))))
+	resp := universalResp(t, base64.StdEncoding.EncodeToString(png.Bytes()), `{"Content-Type": "text/html"}`, "base64")
+
+	data, err := resp.Results[0].Screenshot()
+	assert.NoError(t, err)
+	assert.Equal(t, png.Bytes(), data)
+
+	// The content may also have been converted to text by the SDK.
+	result := response.Results[Content]{Content: "\ufffdPNG", RawContent: png.Bytes()}
+	img, err := result.ScreenshotImage()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, img.Bounds().Dx())
+}
+
+func TestResp_KeepsUnencodedContent(t *testing.T) {
+	html := `<html><head><meta charset="windows-1252"></head><body>café</body></html>`
+	resp := universalResp(t, html, `{}`, "")
+
+	result := resp.Results[0]
+	assert.Equal(t, html, result.Content)
+	assert.Nil(t, result.RawContent)
+	assert.Equal(t, "text/html", result.ContentType)
+	assert.Equal(t, "utf-8", result.Charset)
+}
+
+func TestResp_InvalidBase64Content(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("<html></html>"))
+	resp := &Resp{}
+	err := resp.UnmarshalJSON([]byte(`{
+		"results": [{"content": "<html>", "page": 1}, {"content": "` + encoded + `", "page": 2}],
+		"job": {"content_encoding": "base64"}
+	}`))
+	assert.NoError(t, err)
+
+	// The content that failed to decode is kept as returned by the API.
+	assert.Error(t, resp.Results[0].DecodeErr)
+	assert.Equal(t, "<html>", resp.Results[0].Content)
+	assert.Nil(t, resp.Results[0].RawContent)
+	assert.NoError(t, resp.Results[1].DecodeErr)
+	assert.Equal(t, "<html></html>", resp.Results[1].Content)
+}
+
+func TestResp_KeepsUnmappedFields(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(`{
+		"results": [{
+			"content": {
+				"asin": "B07FZ8S74R",
+				"results": {"organic": [], "new_results": [1, 2]},
+				"parse_status_code": 12000,
+				"new_field": "value"
+			},
+			"parser_type": "v1"
+		}],
+		"job": {"id": "123"}
+	}`)))
+
+	result := resp.Results[0]
+	assert.Equal(t, "v1", result.ParserType)
+	assert.Equal(t, oxylabs.ParseStatusSuccess, result.ParseStatus)
+	assert.Equal(t, "B07FZ8S74R", result.ContentParsed.Asin)
+	assert.Equal(t, json.RawMessage(`"value"`), result.ContentParsed.Extra["new_field"])
+	assert.Equal(t, json.RawMessage(`[1, 2]`), result.ContentParsed.Results.Extra["new_results"])
+	assert.Nil(t, resp.Job.Extra)
+	assert.JSONEq(t, `{"id": "123"}`, string(resp.JobJSON))
+}
+
+func TestResp_JobFieldTypeChange(t *testing.T) {
+	data := []byte(`{
+		"results": [{"content": "<html></html>"}],
+		"job": {"id": "123", "status": "done", "client_id": "42"}
+	}`)
+
+	resp := &Resp{}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+	assert.Equal(t, "123", resp.Job.ID)
+	assert.Equal(t, json.RawMessage(`"42"`), resp.Job.Extra["client_id"])
+
+	resp = &Resp{Resp: response.Resp[Content]{StrictDecode: true}}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+	assert.Contains(t, resp.Diagnose(), oxylabs.Diagnostic{
+		Kind:     oxylabs.TypeMismatch,
+		Path:     "/job/client_id",
+		Expected: "int",
+		Got:      "string",
+	})
+}
+
+func TestResp_TolerantNumbers(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"results": [{
+			"content": {
+				"price": "24.99",
+				"price_initial": 19.99,
+				"price_shipping": null,
+				"reviews_count": 1043.0,
+				"parse_status_code": 12000
+			}
+		}]
+	}`), resp))
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, oxylabs.NewPrice(24.99), content.Price)
+	assert.Equal(t, oxylabs.NewPrice(19.99), content.PriceInitial)
+	assert.True(t, content.PriceShipping.Present)
+	assert.False(t, content.PriceShipping.Valid)
+	assert.False(t, content.PriceSns.Present)
+	assert.Equal(t, oxylabs.NewInt(1043), content.ReviewsCount)
+}
+
+func TestResp_KeepsValuesThatAreNotNumbers(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true, StrictDecode: true}}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"results": [{"content": {"price": "N/A", "reviews_count": "1,043", "parse_status_code": 12000}}]
+	}`), resp))
+
+	content := resp.Results[0].ContentParsed
+	assert.False(t, content.Price.Valid)
+	assert.Equal(t, `"N/A"`, content.Price.Raw)
+	assert.Equal(t, oxylabs.Int{Present: true, Raw: `"1,043"`}, content.ReviewsCount)
+
+	diagnostics := resp.Diagnose()
+	assert.Contains(t, diagnostics, oxylabs.Diagnostic{
+		Kind:     oxylabs.TypeMismatch,
+		Path:     "/results/0/content/price",
+		Expected: "oxylabs.Price",
+		Got:      "string",
+	})
+	assert.Contains(t, diagnostics, oxylabs.Diagnostic{
+		Kind:     oxylabs.TypeMismatch,
+		Path:     "/results/0/content/reviews_count",
+		Expected: "oxylabs.Int",
+		Got:      "string",
+	})
+}
+
+func TestResp_PricesAsMoney(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"results": [{
+			"content": {
+				"results": {
+					"organic": [{"price": 12.99, "price_upper": 15.99, "price_str": "$12.99–$15.99", "currency": "USD"}]
+				}
+			}
+		}]
+	}`), resp))
+
+	organic := resp.Results[0].ContentParsed.Results.Organic[0]
+	price, err := organic.Price.Money(organic.Currency)
+	assert.NoError(t, err)
+	assert.Equal(t, "12.99 USD", price.String())
+
+	prices, err := organic.PriceStr.MoneyRange()
+	assert.NoError(t, err)
+	assert.Equal(t, "12.99 USD", prices.Min.String())
+	assert.Equal(t, "15.99 USD", prices.Max.String())
+}
+
+func TestResp_AllProducts(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"results": [
+			{"content": {"results": {
+				"organic": [{"pos": 1, "url": "/dp/B0001", "asin": "B0001", "pos_overall": 2}],
+				"paid": [{"pos": 1, "url": "/dp/B0002", "asin": "B0002", "pos_overall": 1}]
+			}}, "page": 1},
+			{"content": {"results": {
+				"organic": [
+					{"pos": 1, "url": "/dp/B0001", "asin": "B0001"},
+					{"pos": 2, "url": "/dp/B0003", "asin": "B0003"}
+				],
+				"paid": [{"pos": 1, "url": "/dp/B0004", "asin": "B0004"}]
+			}}, "page": 2}
+		]
+	}`), resp))
+
+	products := resp.AllProducts()
+	asins := []string{}
+	for _, p := range products {
+		asins = append(asins, p.Item.Asin)
+	}
+	assert.Equal(t, []string{"B0002", "B0001", "B0003", "B0004"}, asins)
+	assert.True(t, products[0].Item.IsSponsored)
+	assert.NotNil(t, products[0].Item.Paid)
+	assert.NotNil(t, products[1].Item.Organic)
+	assert.Equal(t, 4, products[3].Pos)
+	assert.Equal(t, 2, products[3].Page)
+
+	assert.Len(t, resp.AllOrganic(), 2)
+	assert.Len(t, resp.AllPaid(), 2)
+}
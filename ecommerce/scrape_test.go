@@ -0,0 +1,17 @@
+package ecommerce
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPayload_BrowserInstructionsUnsupported(t *testing.T) {
+	_, err := BuildPayload(oxylabs.WayfairSearch, "chair", oxylabs.Params{
+		"browser_instructions": []interface{}{
+			map[string]interface{}{"type": "scroll_to_bottom"},
+		},
+	})
+	assert.EqualError(t, err, "invalid browser_instructions parameter: not supported by the wayfair_search source")
+}
@@ -0,0 +1,14 @@
+package ecommerce
+
+import (
+	"context"
+)
+
+// GetScreenshot downloads the PNG of the push-pull job
+// with the render parameter set to oxylabs.PNG.
+func (c *EcommerceClientAsync) GetScreenshot(
+	ctx context.Context,
+	jobID string,
+) ([]byte, error) {
+	return c.C.GetScreenshot(ctx, jobID)
+}
@@ -0,0 +1,346 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// AmazonProductContent is the parsed content of the amazon_product source.
+type AmazonProductContent struct {
+	Url                    string                         `json:"url"`
+	Asin                   string                         `json:"asin"`
+	AsinInUrl              string                         `json:"asin_in_url"`
+	Title                  string                         `json:"title"`
+	ProductName            string                         `json:"product_name"`
+	Manufacturer           string                         `json:"manufacturer"`
+	Description            string                         `json:"description"`
+	BulletPoints           string                         `json:"bullet_points"`
+	Images                 interface{}                    `json:"images"`
+	Variation              interface{}                    `json:"variation"`
+	Price                  oxylabs.Price                  `json:"price"`
+	PriceUpper             oxylabs.Price                  `json:"price_upper"`
+	PriceSns               oxylabs.Price                  `json:"price_sns"`
+	PriceInitial           oxylabs.Price                  `json:"price_initial"`
+	PriceShipping          oxylabs.Price                  `json:"price_shipping"`
+	PriceBuybox            oxylabs.Price                  `json:"price_buybox"`
+	Currency               string                         `json:"currency"`
+	Stock                  string                         `json:"stock"`
+	Coupon                 string                         `json:"coupon"`
+	DealType               string                         `json:"deal_type"`
+	DiscountEnd            string                         `json:"discount_end"`
+	LightningDeal          interface{}                    `json:"lightning_deal"`
+	SNSDiscounts           []interface{}                  `json:"sns_discounts"`
+	PricingCount           oxylabs.Int                    `json:"pricing_count"`
+	PricingStr             string                         `json:"pricing_str"`
+	PricingURL             string                         `json:"pricing_url"`
+	Rating                 oxylabs.Float                  `json:"rating"`
+	ReviewsCount           oxylabs.Int                    `json:"reviews_count"`
+	RatingStarDistribution []AmazonRatingStarDistribution `json:"rating_star_distribution"`
+	TopReview              string                         `json:"top_review"`
+	Reviews                []AmazonReviews                `json:"reviews"`
+	AnsweredQuestionsCount oxylabs.Int                    `json:"answered_questions_count"`
+	Category               []AmazonProductCategory        `json:"category"`
+	SalesRank              []AmazonProductSalesRank       `json:"sales_rank"`
+	Delivery               []AmazonProductDelivery        `json:"delivery"`
+	Ads                    []AmazonProductAds             `json:"ads"`
+	ProductDetails         ProductDetails                 `json:"product_details"`
+	ProductDimensions      string                         `json:"product_dimensions"`
+	DeveloperInfo          []interface{}                  `json:"developer_info"`
+	FeaturedMerchant       []interface{}                  `json:"featured_merchant"`
+	RefurbishedProduct     AmazonRefurbishedProduct       `json:"refurbished_product"`
+	MaxQuantity            oxylabs.Int                    `json:"max_quantity"`
+	HasVideos              bool                           `json:"has_videos"`
+	IsAddonItem            bool                           `json:"is_addon_item"`
+	IsPrimePantry          bool                           `json:"is_prime_pantry"`
+	IsPrimeEligible        bool                           `json:"is_prime_eligible"`
+	PageType               string                         `json:"page_type"`
+	ParseStatusCode        oxylabs.Int                    `json:"parse_status_code"`
+	Errors                 interface{}                    `json:"_errors" oxy:"optional"`
+	Warnings               []string                       `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AmazonSearchContent is the parsed content of the amazon_search source.
+type AmazonSearchContent struct {
+	Url             string      `json:"url"`
+	Page            oxylabs.Int `json:"page"`
+	Pages           oxylabs.Int `json:"pages"`
+	Query           string      `json:"query"`
+	Results         Result      `json:"results"`
+	LastVisiblePage oxylabs.Int `json:"last_visible_page"`
+	PageType        string      `json:"page_type"`
+	ParseStatusCode oxylabs.Int `json:"parse_status_code"`
+	Errors          interface{} `json:"_errors" oxy:"optional"`
+	Warnings        []string    `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AmazonPricingContent is the parsed content of the amazon_pricing source.
+type AmazonPricingContent struct {
+	Url             string        `json:"url"`
+	Asin            string        `json:"asin"`
+	AsinInUrl       string        `json:"asin_in_url"`
+	Title           string        `json:"title"`
+	Page            oxylabs.Int   `json:"page"`
+	Pages           oxylabs.Int   `json:"pages"`
+	Rating          oxylabs.Float `json:"rating"`
+	ReviewsCount    oxylabs.Int   `json:"reviews_count"`
+	Pricing         []Pricing     `json:"pricing"`
+	PageType        string        `json:"page_type"`
+	ParseStatusCode oxylabs.Int   `json:"parse_status_code"`
+	Errors          interface{}   `json:"_errors" oxy:"optional"`
+	Warnings        []string      `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AmazonReviewsContent is the parsed content of the amazon_reviews source.
+type AmazonReviewsContent struct {
+	Url                    string                         `json:"url"`
+	Asin                   string                         `json:"asin"`
+	AsinInUrl              string                         `json:"asin_in_url"`
+	Title                  string                         `json:"title"`
+	Page                   oxylabs.Int                    `json:"page"`
+	Pages                  oxylabs.Int                    `json:"pages"`
+	Rating                 oxylabs.Float                  `json:"rating"`
+	ReviewsCount           oxylabs.Int                    `json:"reviews_count"`
+	RatingStarDistribution []AmazonRatingStarDistribution `json:"rating_star_distribution"`
+	Reviews                []AmazonReviews                `json:"reviews"`
+	PageType               string                         `json:"page_type"`
+	ParseStatusCode        oxylabs.Int                    `json:"parse_status_code"`
+	Errors                 interface{}                    `json:"_errors" oxy:"optional"`
+	Warnings               []string                       `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AmazonQuestionsContent is the parsed content of the amazon_questions source.
+type AmazonQuestionsContent struct {
+	Url             string            `json:"url"`
+	Asin            string            `json:"asin"`
+	Page            oxylabs.Int       `json:"page"`
+	Pages           oxylabs.Int       `json:"pages"`
+	Questions       []AmazonQuestions `json:"questions"`
+	QuestionsTotal  oxylabs.Int       `json:"questions_total"`
+	PageType        string            `json:"page_type"`
+	ParseStatusCode oxylabs.Int       `json:"parse_status_code"`
+	Errors          interface{}       `json:"_errors" oxy:"optional"`
+	Warnings        []string          `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AmazonBestsellersContent is the parsed content of the amazon_bestsellers source.
+type AmazonBestsellersContent struct {
+	Url             string              `json:"url"`
+	Page            oxylabs.Int         `json:"page"`
+	Pages           oxylabs.Int         `json:"pages"`
+	Query           string              `json:"query"`
+	Results         []AmazonBestsellers `json:"results"`
+	PageType        string              `json:"page_type"`
+	ParseStatusCode oxylabs.Int         `json:"parse_status_code"`
+	Errors          interface{}         `json:"_errors" oxy:"optional"`
+	Warnings        []string            `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type AmazonBestsellers struct {
+	Pos          oxylabs.Int       `json:"pos"`
+	Url          string            `json:"url"`
+	Asin         string            `json:"asin"`
+	Price        oxylabs.Price     `json:"price"`
+	Title        string            `json:"title"`
+	Rating       oxylabs.Float     `json:"rating"`
+	Currency     string            `json:"currency"`
+	IsPrime      bool              `json:"is_prime"`
+	PriceStr     oxylabs.PriceText `json:"price_str"`
+	PriceUpper   oxylabs.Price     `json:"price_upper"`
+	RatingsCount oxylabs.Int       `json:"ratings_count"`
+}
+
+// AmazonSellersContent is the parsed content of the amazon_sellers source.
+type AmazonSellersContent struct {
+	Url                  string               `json:"url"`
+	BusinessName         string               `json:"business_name"`
+	BusinessAddress      string               `json:"business_address"`
+	RecentFeedback       []RecentFeedback     `json:"recent_feedback"`
+	FeedbackSummaryTable FeedbackSummaryTable `json:"feedback_summary_table"`
+	PageType             string               `json:"page_type"`
+	ParseStatusCode      oxylabs.Int          `json:"parse_status_code"`
+	Errors               interface{}          `json:"_errors" oxy:"optional"`
+	Warnings             []string             `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GoogleShoppingSearchContent is the parsed content of the google_shopping_search source.
+type GoogleShoppingSearchContent struct {
+	Url             string      `json:"url"`
+	Page            oxylabs.Int `json:"page"`
+	Query           string      `json:"query"`
+	Results         Result      `json:"results"`
+	LastVisiblePage oxylabs.Int `json:"last_visible_page"`
+	ParseStatusCode oxylabs.Int `json:"parse_status_code"`
+	Errors          interface{} `json:"_errors" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GoogleShoppingProductContent is the parsed content of the google_shopping_product source.
+type GoogleShoppingProductContent struct {
+	Url             string         `json:"url"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	Images          interface{}    `json:"images"`
+	Variants        Variants       `json:"variants"`
+	Highlights      []string       `json:"highlights"`
+	RelatedItems    RelatedItems   `json:"related_items"`
+	Specifications  Specifications `json:"specifications"`
+	ParseStatusCode oxylabs.Int    `json:"parse_status_code"`
+	Errors          interface{}    `json:"_errors" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GoogleShoppingPricingContent is the parsed content of the google_shopping_pricing source.
+type GoogleShoppingPricingContent struct {
+	Url             string        `json:"url"`
+	Title           string        `json:"title"`
+	Page            oxylabs.Int   `json:"page"`
+	Pages           oxylabs.Int   `json:"pages"`
+	Rating          oxylabs.Float `json:"rating"`
+	ReviewCount     oxylabs.Int   `json:"review_count"`
+	Pricing         []Pricing     `json:"pricing"`
+	ParseStatusCode oxylabs.Int   `json:"parse_status_code"`
+	Errors          interface{}   `json:"_errors" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON unmarshals the AmazonProductContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonProductContent) UnmarshalJSON(data []byte) error {
+	type amazonProductContent AmazonProductContent
+	return internal.UnmarshalExtra(data, (*amazonProductContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonSearchContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonSearchContent) UnmarshalJSON(data []byte) error {
+	type amazonSearchContent AmazonSearchContent
+	return internal.UnmarshalExtra(data, (*amazonSearchContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonPricingContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonPricingContent) UnmarshalJSON(data []byte) error {
+	type amazonPricingContent AmazonPricingContent
+	return internal.UnmarshalExtra(data, (*amazonPricingContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonReviewsContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonReviewsContent) UnmarshalJSON(data []byte) error {
+	type amazonReviewsContent AmazonReviewsContent
+	return internal.UnmarshalExtra(data, (*amazonReviewsContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonQuestionsContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonQuestionsContent) UnmarshalJSON(data []byte) error {
+	type amazonQuestionsContent AmazonQuestionsContent
+	return internal.UnmarshalExtra(data, (*amazonQuestionsContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonBestsellersContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonBestsellersContent) UnmarshalJSON(data []byte) error {
+	type amazonBestsellersContent AmazonBestsellersContent
+	return internal.UnmarshalExtra(data, (*amazonBestsellersContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the AmazonSellersContent and keeps the fields that are not mapped in Extra.
+func (a *AmazonSellersContent) UnmarshalJSON(data []byte) error {
+	type amazonSellersContent AmazonSellersContent
+	return internal.UnmarshalExtra(data, (*amazonSellersContent)(a), &a.Extra)
+}
+
+// UnmarshalJSON unmarshals the GoogleShoppingSearchContent and keeps the fields that are not mapped in Extra.
+func (g *GoogleShoppingSearchContent) UnmarshalJSON(data []byte) error {
+	type googleShoppingSearchContent GoogleShoppingSearchContent
+	return internal.UnmarshalExtra(data, (*googleShoppingSearchContent)(g), &g.Extra)
+}
+
+// UnmarshalJSON unmarshals the GoogleShoppingProductContent and keeps the fields that are not mapped in Extra.
+func (g *GoogleShoppingProductContent) UnmarshalJSON(data []byte) error {
+	type googleShoppingProductContent GoogleShoppingProductContent
+	return internal.UnmarshalExtra(data, (*googleShoppingProductContent)(g), &g.Extra)
+}
+
+// UnmarshalJSON unmarshals the GoogleShoppingPricingContent and keeps the fields that are not mapped in Extra.
+func (g *GoogleShoppingPricingContent) UnmarshalJSON(data []byte) error {
+	type googleShoppingPricingContent GoogleShoppingPricingContent
+	return internal.UnmarshalExtra(data, (*googleShoppingPricingContent)(g), &g.Extra)
+}
+
+// scrapeParsed scrapes the source with parsing enabled and decodes
+// the parsed content of the results into T.
+func scrapeParsed[T any](
+	ctx context.Context,
+	c *EcommerceClient,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (*response.Resp[T], error) {
+	params["parse"] = true
+
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ScrapeParsed[T](ctx, c.C, payload, false)
+}
+
+// scrapeParsedAsync scrapes the source with async polling runtime and parsing
+// enabled, and decodes the parsed content of the results into T.
+func scrapeParsedAsync[T any](
+	ctx context.Context,
+	c *EcommerceClientAsync,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (chan *response.Resp[T], error) {
+	respChan := make(chan *response.Resp[T])
+	params["parse"] = true
+
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := response.ScrapeParsed[T](ctx, c.C, payload, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Forward the response to the resp channel.
+	go func() {
+		respChan <- res
+	}()
+
+	return respChan, nil
+}
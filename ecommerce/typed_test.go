@@ -0,0 +1,62 @@
+package ecommerce
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcommerceClient_ScrapeAmazonReviewsParsed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"results": [{
+				"content": {
+					"asin": "B07FZ8S74R",
+					"pages": 1,
+					"rating": 4.5,
+					"reviews": [{"id": "R1", "title": "Great", "rating": 5, "is_verified": true}],
+					"reviews_count": 1,
+					"parse_status_code": 12000
+				},
+				"page": 1,
+				"status_code": 200
+			}],
+			"job": {"id": "123", "source": "amazon_reviews"}
+		}`))
+	}))
+	defer server.Close()
+
+	c := &EcommerceClient{
+		C: &internal.Client{
+			BaseUrl: server.URL,
+			ApiCredentials: &internal.ApiCredentials{
+				Username: "username",
+				Password: "password",
+			},
+			HttpClient: server.Client(),
+		},
+	}
+
+	resp, err := c.ScrapeAmazonReviewsParsed("B07FZ8S74R")
+	assert.NoError(t, err)
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, "B07FZ8S74R", content.Asin)
+	assert.Equal(t, oxylabs.NewFloat(4.5), content.Rating)
+	assert.Equal(t, "Great", content.Reviews[0].Title)
+	assert.True(t, content.Reviews[0].IsVerified)
+}
+
+func TestGetResp_TypedErrorStatus(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	recorder.WriteHeader(http.StatusUnauthorized)
+	recorder.WriteString(`{"message": "Unauthorized"}`)
+
+	_, err := response.GetResp[AmazonProductContent](recorder.Result(), true, false)
+	assert.ErrorContains(t, err, "401")
+}
@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -10,32 +9,19 @@ import (
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
-// UniversalUrlOpts contains all the query parameters available for universal url scrape.
-type UniversalUrlOpts struct {
-	UserAgent         oxylabs.UserAgent
-	CallbackUrl       string
-	GeoLocation       string
-	Locale            oxylabs.Locale
-	Render            oxylabs.Render
-	ContentEncoding   string
-	Context           []func(oxylabs.ContextOption)
-	CallbackURL       string
-	Parse             bool
-	ParserType        interface{}
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
+// universalUrlContextKeys contains the context options accepted by UniversalUrlOpts.
+var universalUrlContextKeys = []internal.ContextKey{
+	{Name: "content", Type: internal.ValueString},
+	{Name: "cookies", Type: internal.ValueKeyValues},
+	{Name: "follow_redirects", Type: internal.ValueBool},
+	{Name: "headers", Type: internal.ValueStringMap},
+	{Name: "http_method", Type: internal.ValueString, Default: "get"},
+	{Name: "session_id", Type: internal.ValueString},
+	{Name: "successful_status_codes", Type: internal.ValueIntList},
 }
 
-// checkParameterValidity checks validity of UniversalUrlOpts parameters.
-func (opt *UniversalUrlOpts) checkParametersValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
+// validateUniversalUrl checks validity of the http_method and content context options.
+func validateUniversalUrl(params map[string]interface{}, ctx oxylabs.ContextOption) error {
 	if ctx["http_method"] != "post" && ctx["http_method"] != "get" {
 		return fmt.Errorf("invalid http method")
 	}
@@ -44,15 +30,64 @@ func (opt *UniversalUrlOpts) checkParametersValidity(ctx oxylabs.ContextOption)
 		return fmt.Errorf("content is useful only if http method is post")
 	}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
 	return nil
 }
 
+// universalUrlSource describes the universal_ecommerce source.
+var universalUrlSource = &internal.SourceSpec{
+	Source: oxylabs.Universal,
+	Input:  internal.InputUrl,
+	Params: []internal.Param{
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "content_encoding", Type: internal.ValueString, Default: "base64"},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+		{Name: "parser_type", Type: internal.ValueAny},
+	},
+	ContextKeys: universalUrlContextKeys,
+	Validate:    validateUniversalUrl,
+}
+
+// UniversalUrlOpts contains all the query parameters available for universal url scrape.
+type UniversalUrlOpts struct {
+	UserAgent           oxylabs.UserAgent
+	CallbackUrl         string
+	GeoLocation         string
+	Locale              oxylabs.Locale
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	ContentEncoding     string
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+	CallbackURL         string
+	Parse               bool
+	ParserType          interface{}
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the UniversalUrlOpts as request parameters.
+func (opt *UniversalUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"user_agent_type":      opt.UserAgent,
+		"callback_url":         opt.CallbackUrl,
+		"geo_location":         opt.GeoLocation,
+		"locale":               opt.Locale,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"content_encoding":     opt.ContentEncoding,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+		"parse":                opt.Parse,
+		"parser_type":          opt.ParserType,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
+}
+
 // ScrapeUniversalUrl scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
 func (c *EcommerceClient) ScrapeUniversalUrl(
 	url string,
@@ -64,6 +99,15 @@ func (c *EcommerceClient) ScrapeUniversalUrl(
 	return c.ScrapeUniversalUrlCtx(ctx, url, opts...)
 }
 
+// BuildUniversalUrlPayload returns the JSON payload that ScrapeUniversalUrl would send
+// for the given url and options, without sending it.
+func BuildUniversalUrlPayload(
+	url string,
+	opts ...*UniversalUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.Universal, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeUniversalUrlCtx scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeUniversalUrlCtx(
@@ -71,97 +115,5 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 	url string,
 	opts ...*UniversalUrlOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &UniversalUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultHttpMethod(context)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultContentEncoding(&opt.ContentEncoding)
-
-	// Check validity of parameters.
-	err := opt.checkParametersValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":           oxylabs.Universal,
-		"url":              url,
-		"user_agent_type":  opt.UserAgent,
-		"geo_location":     opt.GeoLocation,
-		"locale":           opt.Locale,
-		"render":           opt.Render,
-		"content_encoding": opt.ContentEncoding,
-		"context": []map[string]interface{}{
-			{
-				"key":   "content",
-				"value": context["content"],
-			},
-			{
-				"key":   "cookies",
-				"value": context["cookies"],
-			},
-			{
-				"key":   "follow_redirects",
-				"value": context["follow_redirects"],
-			},
-			{
-				"key":   "headers",
-				"value": context["headers"],
-			},
-			{
-				"key":   "http_method",
-				"value": context["http_method"],
-			},
-			{
-				"key":   "session_id",
-				"value": context["session_id"],
-			},
-			{
-				"key":   "successful_status_codes",
-				"value": context["successful_status_codes"],
-			},
-		},
-		"callback_url": opt.CallbackUrl,
-		"parse":        opt.Parse,
-		"parser_type":  opt.ParserType,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c.Scrape(ctx, oxylabs.Universal, url, internal.LastOpt(opts).params())
 }
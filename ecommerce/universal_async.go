@@ -2,9 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
@@ -30,123 +27,5 @@ func (c *EcommerceClientAsync) ScrapeUniversalUrlCtx(
 	url string,
 	opts ...*UniversalUrlOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &UniversalUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultHttpMethod(context)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultContentEncoding(&opt.ContentEncoding)
-
-	// Check validity of parameters.
-	err := opt.checkParametersValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":           oxylabs.Universal,
-		"url":              url,
-		"user_agent_type":  opt.UserAgent,
-		"geo_location":     opt.GeoLocation,
-		"locale":           opt.Locale,
-		"render":           opt.Render,
-		"content_encoding": opt.ContentEncoding,
-		"context": []map[string]interface{}{
-			{
-				"key":   "content",
-				"value": context["content"],
-			},
-			{
-				"key":   "cookies",
-				"value": context["cookies"],
-			},
-			{
-				"key":   "follow_redirects",
-				"value": context["follow_redirects"],
-			},
-			{
-				"key":   "headers",
-				"value": context["headers"],
-			},
-			{
-				"key":   "http_method",
-				"value": context["http_method"],
-			},
-			{
-				"key":   "session_id",
-				"value": context["session_id"],
-			},
-			{
-				"key":   "successful_status_codes",
-				"value": context["successful_status_codes"],
-			},
-		},
-		"callback_url": opt.CallbackUrl,
-		"parse":        opt.Parse,
-		"parser_type":  opt.ParserType,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	/// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.Universal, url, internal.LastOpt(opts).params())
 }
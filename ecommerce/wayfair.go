@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -10,29 +9,30 @@ import (
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
-// checkParameterValidity checks validity of ScrapeWayfairSearch parameters.
-func (opt *WayfairSearchOpts) checkParametersValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.Limit != 24 && opt.Limit != 48 && opt.Limit != 96 {
-		return fmt.Errorf("invalid limit parameter: %v", opt.Limit)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// validateWayfairLimit checks validity of the limit parameter.
+func validateWayfairLimit(params map[string]interface{}, ctx oxylabs.ContextOption) error {
+	if limit, ok := params["limit"].(int); ok && limit != 24 && limit != 48 && limit != 96 {
+		return fmt.Errorf("invalid limit parameter: %v", limit)
 	}
 
 	return nil
 }
 
+// wayfairSearchSource describes the wayfair_search source.
+var wayfairSearchSource = &internal.SourceSpec{
+	Source: oxylabs.WayfairSearch,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "limit", Type: internal.ValueInt, Default: internal.DefaultLimit_ECOMMERCE},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	Validate: validateWayfairLimit,
+}
+
 // WayfairSearchOpts contains all the query parameters available for wayfair_search.
 type WayfairSearchOpts struct {
 	StartPage         int
@@ -40,10 +40,25 @@ type WayfairSearchOpts struct {
 	Limit             int
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// params returns the WayfairSearchOpts as request parameters.
+func (opt *WayfairSearchOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"limit":                opt.Limit,
+		"user_agent_type":      opt.UserAgent,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
+}
+
 // ScrapeWayfairSearch scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
 func (c *EcommerceClient) ScrapeWayfairSearch(
 	query string,
@@ -55,6 +70,15 @@ func (c *EcommerceClient) ScrapeWayfairSearch(
 	return c.ScrapeWayfairSearchCtx(ctx, query, opts...)
 }
 
+// BuildWayfairSearchPayload returns the JSON payload that ScrapeWayfairSearch would send
+// for the given query and options, without sending it.
+func BuildWayfairSearchPayload(
+	query string,
+	opts ...*WayfairSearchOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.WayfairSearch, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeWayfairSearchCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeWayfairSearchCtx(
@@ -62,85 +86,39 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 	query string,
 	opts ...*WayfairSearchOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &WayfairSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_ECOMMERCE)
-
-	// Check validity of parameters.
-	err := opt.checkParametersValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.WayfairSearch,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.WayfairSearch, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// wayfairUrlSource describes the wayfair source.
+var wayfairUrlSource = &internal.SourceSpec{
+	Source: oxylabs.Wayfair,
+	Input:  internal.InputUrl,
+	Host:   "wayfair",
+	Params: []internal.Param{
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // WayfairUrlOpts contains all the query parameters available for wayfair.
 type WayfairUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
-// checkParameterValidity checks validity of ScrapeWayfairUrl parameters.
-func (opt *WayfairUrlOpts) checkParametersValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+// params returns the WayfairUrlOpts as request parameters.
+func (opt *WayfairUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"user_agent_type":      opt.UserAgent,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
 	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
 }
 
 // ScrapeWayfairUrl scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
@@ -154,6 +132,15 @@ func (c *EcommerceClient) ScrapeWayfairUrl(
 	return c.ScrapeWayfairUrlCtx(ctx, url, opts...)
 }
 
+// BuildWayfairUrlPayload returns the JSON payload that ScrapeWayfairUrl would send
+// for the given url and options, without sending it.
+func BuildWayfairUrlPayload(
+	url string,
+	opts ...*WayfairUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.Wayfair, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeWayfairUrlCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *EcommerceClient) ScrapeWayfairUrlCtx(
@@ -161,59 +148,5 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 	url string,
 	opts ...*WayfairUrlOpts,
 ) (*Resp, error) {
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "wayfair")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &WayfairUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParametersValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.Wayfair,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c.Scrape(ctx, oxylabs.Wayfair, url, internal.LastOpt(opts).params())
 }
@@ -0,0 +1,24 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWayfairSearchPayload_Parse(t *testing.T) {
+	jsonPayload, err := BuildWayfairSearchPayload(
+		"chair",
+		&WayfairSearchOpts{
+			Parse: true,
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, "wayfair_search", payload["source"])
+	assert.Equal(t, true, payload["parse"])
+	assert.Equal(t, float64(48), payload["limit"])
+}
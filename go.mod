@@ -2,10 +2,18 @@ module github.com/oxylabs/oxylabs-sdk-go
 
 go 1.21.0
 
-require github.com/stretchr/testify v1.8.4
+require (
+	github.com/andybalholm/cascadia v1.3.2
+	github.com/antchfx/htmlquery v1.3.1
+	github.com/antchfx/xpath v1.3.0
+	github.com/stretchr/testify v1.8.4
+	golang.org/x/net v0.25.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
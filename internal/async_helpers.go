@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // GetJobID Helper function to make a POST req and retrieve the Job ID.
@@ -40,7 +44,7 @@ func (c *Client) GetJobID(
 	}
 
 	// Unmarshal into job.
-	job := &Job{}
+	job := &oxylabs.Job{}
 	if err = json.Unmarshal(respBody, &job); err != nil {
 		return "", fmt.Errorf("error unmarshalling job resp body: %v", err)
 	}
@@ -131,7 +135,7 @@ func (c *Client) PollJobStatus(
 		}
 
 		// Unmarshal into job.
-		job := &Job{}
+		job := &oxylabs.Job{}
 		if err = json.Unmarshal(respBody, &job); err != nil {
 			err = fmt.Errorf("error unmarshalling job resp body: %v", err)
 			errChan <- err
@@ -140,10 +144,10 @@ func (c *Client) PollJobStatus(
 		}
 
 		// Check job status.
-		if job.Status == "done" {
+		if job.Status == oxylabs.JobStatusDone {
 			c.GetHttpResp(job.ID, httpRespChan, errChan)
 			return
-		} else if job.Status == "faulted" {
+		} else if job.Status == oxylabs.JobStatusFaulted {
 			err = fmt.Errorf("there was an error processing your query")
 			errChan <- err
 			close(httpRespChan)
@@ -152,7 +156,7 @@ func (c *Client) PollJobStatus(
 
 		select {
 		case <-ctx.Done():
-			err = fmt.Errorf("timeout exceeded")
+			err = fmt.Errorf("timeout exceeded: %w", ctx.Err())
 			errChan <- err
 			close(httpRespChan)
 			return
@@ -162,8 +166,48 @@ func (c *Client) PollJobStatus(
 	}
 }
 
-// Job struct to get job id and status for the async polling.
-type Job struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+// FollowLink requests the resource of the job link, e.g. the results of
+// the job. The credentials of the client are only sent to Oxylabs hosts,
+// which are requested over HTTPS, and to the host of the base URL.
+func (c *Client) FollowLink(
+	ctx context.Context,
+	link oxylabs.JobLink,
+) (*http.Response, error) {
+	u, err := url.Parse(link.Href)
+	if err != nil {
+		return nil, fmt.Errorf("invalid link %q: %v", link.Href, err)
+	}
+	oxylabsHost := u.Hostname() == "oxylabs.io" || strings.HasSuffix(u.Hostname(), ".oxylabs.io")
+	if oxylabsHost && u.Scheme == "http" {
+		u.Scheme = "https"
+	}
+
+	method := link.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	req, err := NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-type", "application/json")
+	if oxylabsHost || c.isBaseHost(u) {
+		req.SetBasicAuth(
+			c.ApiCredentials.Username,
+			c.ApiCredentials.Password,
+		)
+	}
+
+	return c.HttpClient.Do(req)
+}
+
+// isBaseHost reports whether the URL has the scheme and host of the base URL.
+func (c *Client) isBaseHost(u *url.URL) bool {
+	base, err := url.Parse(c.BaseUrl)
+	if err != nil {
+		return false
+	}
+
+	return base.Host != "" && strings.EqualFold(base.Host, u.Host) && base.Scheme == u.Scheme
 }
@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func testClient(server *httptest.Server) *Client {
+	return &Client{
+		BaseUrl: server.URL + "/v1/queries",
+		ApiCredentials: &ApiCredentials{
+			Username: "username",
+			Password: "password",
+		},
+		HttpClient: server.Client(),
+	}
+}
+
+// hostTransport sends every request to the test server, whatever its host.
+type hostTransport struct {
+	server *httptest.Server
+}
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+func TestClient_FollowLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/queries/123/results", r.URL.Path)
+		user, _, _ := r.BasicAuth()
+		assert.Equal(t, "username", user)
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	c := testClient(server)
+	job := oxylabs.Job{Links: oxylabs.JobLinks{
+		{Rel: oxylabs.JobLinkResults, Href: c.BaseUrl + "/123/results", Method: "GET"},
+	}}
+	link, ok := job.Link(oxylabs.JobLinkResults)
+	assert.True(t, ok)
+
+	httpResp, err := c.FollowLink(context.Background(), link)
+	assert.NoError(t, err)
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"results": []}`, string(body))
+}
+
+func TestClient_FollowLinkForeignHost(t *testing.T) {
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok, "credentials sent to a foreign host")
+		assert.Empty(t, r.Header.Get("Authorization"))
+	}))
+	defer foreign.Close()
+	api := httptest.NewServer(http.NotFoundHandler())
+	defer api.Close()
+
+	c := testClient(api)
+	c.HttpClient = foreign.Client()
+	httpResp, err := c.FollowLink(context.Background(), oxylabs.JobLink{Href: foreign.URL + "/v1/queries/123/results"})
+	assert.NoError(t, err)
+	httpResp.Body.Close()
+}
+
+func TestClient_FollowLinkOxylabsHost(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+	}))
+	defer server.Close()
+
+	c := testClient(server)
+	c.HttpClient = &http.Client{Transport: upgradeCheck{t, hostTransport{server}}}
+	httpResp, err := c.FollowLink(context.Background(), oxylabs.JobLink{Href: "http://data.oxylabs.io/v1/queries/123/results"})
+	assert.NoError(t, err)
+	httpResp.Body.Close()
+}
+
+// upgradeCheck checks that requests are sent over HTTPS.
+type upgradeCheck struct {
+	t         *testing.T
+	transport http.RoundTripper
+}
+
+func (u upgradeCheck) RoundTrip(req *http.Request) (*http.Response, error) {
+	assert.Equal(u.t, "https", req.URL.Scheme)
+	return u.transport.RoundTrip(req)
+}
+
+func TestClient_PollJobStatusIgnoresOtherJobFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			// A type change of a field polling does not use.
+			w.Write([]byte(`{"id": "123", "status": "done", "render": 5, "created_at": {}}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": []}`))
+		}
+	}))
+	defer server.Close()
+
+	c := testClient(server)
+	c.HttpClient = &http.Client{Transport: hostTransport{server}}
+
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	go c.PollJobStatus(context.Background(), "123", 0, httpRespChan, errChan)
+
+	assert.NoError(t, <-errChan)
+	httpResp := <-httpRespChan
+	defer httpResp.Body.Close()
+	assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+}
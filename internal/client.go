@@ -1,6 +1,10 @@
 package internal
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
 
 type ApiCredentials struct {
 	Username string
@@ -11,4 +15,39 @@ type Client struct {
 	BaseUrl        string
 	ApiCredentials *ApiCredentials
 	HttpClient     *http.Client
+
+	// StrictDecode enables the diagnostics of the decoded responses,
+	// which are passed to DiagnosticHook if it is set.
+	StrictDecode   bool
+	DiagnosticHook oxylabs.DiagnosticHook
+
+	// StrictParse fails the requests whose pages the API failed to parse.
+	StrictParse bool
+}
+
+// EnableStrictDecode enables the strict decode mode, passing the
+// diagnostics of the responses to the hook.
+func (c *Client) EnableStrictDecode(hook oxylabs.DiagnosticHook) {
+	c.StrictDecode = true
+	c.DiagnosticHook = hook
+}
+
+// EnableStrictParse enables the strict parse mode.
+func (c *Client) EnableStrictParse() {
+	c.StrictParse = true
+}
+
+// Diagnose returns the diagnostics of a response in the strict decode mode,
+// passing them to the diagnostic hook. It returns nil otherwise.
+func (c *Client) Diagnose(diagnose func() []oxylabs.Diagnostic) []oxylabs.Diagnostic {
+	if !c.StrictDecode {
+		return nil
+	}
+
+	diagnostics := diagnose()
+	if len(diagnostics) > 0 && c.DiagnosticHook != nil {
+		c.DiagnosticHook(diagnostics)
+	}
+
+	return diagnostics
 }
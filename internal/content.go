@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// ContentEncodingBase64 is the content_encoding the content is
+// base64 encoded with by the API.
+const ContentEncodingBase64 = "base64"
+
+// DecodedContent is the content of a result decoded according to
+// the content encoding it was requested with.
+type DecodedContent struct {
+	// Raw contains the decoded bytes of the content. It is nil if the
+	// content was not encoded.
+	Raw []byte
+
+	// Text contains the content converted to UTF-8. It is empty for binary content.
+	Text string
+
+	ContentType string
+	Charset     string
+	Binary      bool
+}
+
+// DecodeContent decodes the content according to the content encoding. The
+// content type is detected from the signature of binary content, from the
+// contentType header if set and from the content otherwise, and the charset
+// of text content from the header, a byte order mark or the meta tags of
+// HTML documents. Content of an unknown encoding is not decoded.
+func DecodeContent(
+	content string,
+	encoding string,
+	contentType string,
+) (*DecodedContent, error) {
+	// Content that is not encoded was already decoded to UTF-8 with the JSON of the results.
+	var raw []byte
+	utf8 := false
+	switch strings.ToLower(encoding) {
+	case "", "utf-8", "utf8", "none":
+		raw = []byte(content)
+		utf8 = true
+	case ContentEncodingBase64:
+		var err error
+		raw, err = base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding %s content: %w", encoding, err)
+		}
+	default:
+		// Content of an unknown encoding is kept as returned by the API.
+		return &DecodedContent{Text: content}, nil
+	}
+
+	// Binary signatures, e.g. of a PNG or a PDF, take precedence over the
+	// header, so that binary content is never converted as text.
+	detected := http.DetectContentType(raw)
+	if mediaType, _, _ := mime.ParseMediaType(detected); mediaType != "application/octet-stream" && !isTextMediaType(mediaType) {
+		decoded := &DecodedContent{ContentType: mediaType, Binary: true}
+		if !utf8 {
+			decoded.Raw = raw
+		}
+		return decoded, nil
+	}
+
+	// The charset of sniffed content types is a guess, so it is left
+	// to be detected from the content.
+	sniffed := contentType == ""
+	if sniffed {
+		contentType = detected
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.TrimSpace(strings.Split(contentType, ";")[0])
+	}
+	if sniffed {
+		contentType = mediaType
+	}
+
+	decoded := &DecodedContent{
+		ContentType: mediaType,
+		Binary:      !isTextMediaType(mediaType),
+	}
+
+	// Content that is not encoded is kept as it is, without a copy in Raw.
+	if utf8 {
+		if !decoded.Binary {
+			decoded.Text = content
+			decoded.Charset = "utf-8"
+		}
+		return decoded, nil
+	}
+
+	decoded.Raw = raw
+	if decoded.Binary {
+		return decoded, nil
+	}
+
+	enc, name, _ := charset.DetermineEncoding(raw, contentType)
+	text, err := enc.NewDecoder().Bytes(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s content: %w", name, err)
+	}
+	decoded.Text = string(text)
+	decoded.Charset = name
+
+	return decoded, nil
+}
+
+// isTextMediaType reports whether the media type is a text format.
+func isTextMediaType(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "+xml"),
+		strings.HasSuffix(mediaType, "+json"):
+		return true
+	}
+
+	switch mediaType {
+	case "application/json", "application/xml", "application/javascript",
+		"application/ecmascript", "application/x-javascript":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// ContextKey describes a context option accepted by a source.
+// TopLevel options are sent as top-level payload parameters
+// instead of being added to the context parameter.
+// Default is used when the option is not set and Replaces lists
+// the parameters which cannot be used together with the option.
+type ContextKey struct {
+	Name     string
+	Type     ValueType
+	TopLevel bool
+	Default  interface{}
+	Replaces []string
+}
+
+// findContextKey returns the ContextKey with the given name.
+func findContextKey(name string, keys []ContextKey) (ContextKey, bool) {
+	for _, key := range keys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+
+	return ContextKey{}, false
+}
+
+// ValidateContext checks that every set context option is accepted by the source
+// and has the expected value type. Values of compatible types, such as float64
+// numbers or []interface{} lists decoded from JSON, are converted to the expected
+// type in place. If lenient is true, unknown options are allowed.
+func ValidateContext(
+	ctx oxylabs.ContextOption,
+	keys []ContextKey,
+	lenient bool,
+) error {
+	for _, name := range sortedNames(ctx) {
+		key, ok := findContextKey(name, keys)
+		if !ok {
+			if lenient {
+				continue
+			}
+			return &oxylabs.ValidationError{
+				Key:    name,
+				Value:  ctx[name],
+				Reason: fmt.Sprintf("unsupported context option, accepted options are: %s", contextKeyNames(keys)),
+			}
+		}
+
+		if isNil(ctx[name]) {
+			delete(ctx, name)
+			continue
+		}
+
+		value, ok := coerceValue(ctx[name], key.Type)
+		if !ok {
+			return &oxylabs.ValidationError{
+				Key:    name,
+				Value:  ctx[name],
+				Reason: fmt.Sprintf("expected value of type %s, got %T", key.Type, ctx[name]),
+			}
+		}
+		ctx[name] = value
+	}
+
+	return nil
+}
+
+// SetContextPayload adds the context options of the given keys to the payload.
+// Only the keys which are set in ctx are added and the context parameter
+// is omitted from the payload altogether if none of them are set.
+// TopLevel options are added as top-level payload parameters.
+// If lenient is true, unknown context options are forwarded as well.
+func SetContextPayload(
+	payload map[string]interface{},
+	ctx oxylabs.ContextOption,
+	keys []ContextKey,
+	lenient bool,
+) {
+	entries := []map[string]interface{}{}
+	for _, key := range keys {
+		if isNil(ctx[key.Name]) {
+			continue
+		}
+		if key.TopLevel {
+			payload[key.Name] = ctx[key.Name]
+			continue
+		}
+		entries = append(entries, map[string]interface{}{
+			"key":   key.Name,
+			"value": ctx[key.Name],
+		})
+	}
+
+	if lenient {
+		for _, name := range sortedNames(ctx) {
+			if _, ok := findContextKey(name, keys); ok || isNil(ctx[name]) {
+				continue
+			}
+			entries = append(entries, map[string]interface{}{
+				"key":   name,
+				"value": ctx[name],
+			})
+		}
+	}
+
+	if len(entries) > 0 {
+		payload["context"] = entries
+	}
+}
+
+// sortedNames returns the names of the set options in sorted order.
+func sortedNames(options map[string]interface{}) []string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// contextKeyNames returns a comma separated list of the context key names.
+func contextKeyNames(keys []ContextKey) string {
+	if len(keys) == 0 {
+		return "none"
+	}
+
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
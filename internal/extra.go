@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// UnmarshalExtra unmarshals the JSON object into v, a pointer to a struct,
+// and sets extra to the fields of the object that are not mapped by the
+// json tags of the struct, so that fields added to the API are not lost.
+func UnmarshalExtra(data []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	*extra = ExtraFields(data, reflect.TypeOf(v).Elem())
+	return nil
+}
+
+// ExtraFields returns the fields of the JSON object that are not mapped
+// by the struct type t, or nil if every field is mapped.
+func ExtraFields(data []byte, t reflect.Type) map[string]json.RawMessage {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	known := JSONFieldNames(t)
+	for name := range fields {
+		// Fields are matched case-insensitively, as by encoding/json.
+		if known[strings.ToLower(name)] {
+			delete(fields, name)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return fields
+}
+
+// JSONFieldNames returns the lower case names of the JSON fields of the struct type t.
+func JSONFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			for name := range JSONFieldNames(f.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+
+	return names
+}
+
+// ContentStatus contains the parse status, the warnings and the errors
+// of the parsed content of a result.
+type ContentStatus struct {
+	ParseStatusCode int         `json:"parse_status_code"`
+	Warnings        []string    `json:"_warnings"`
+	Errors          interface{} `json:"_errors"`
+}
+
+// ParseStatus returns the parse status of the parsed content of a result.
+// It is zero if the content is not a JSON object, e.g. when the result
+// is not parsed.
+func ParseStatus(content json.RawMessage) ContentStatus {
+	var status ContentStatus
+	// Fields of unexpected types are left unset, the others are still decoded.
+	_ = json.Unmarshal(content, &status)
+
+	return status
+}
@@ -34,7 +34,7 @@ func (c *Client) Req(
 	// Get resp.
 	resp, err := c.HttpClient.Do(req)
 	if e, ok := err.(net.Error); ok && e.Timeout() {
-		return nil, fmt.Errorf("timeout error: %v", err)
+		return nil, fmt.Errorf("timeout error: %w", err)
 	} else if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,311 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+var (
+	rawMessageType  = reflect.TypeOf(json.RawMessage{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	pointerEscaper  = strings.NewReplacer("~", "~0", "/", "~1")
+)
+
+// CheckSchema compares the JSON value with the type t it is decoded into and
+// returns the fields that are not in t and the values that do not match their
+// type. The fields of t that are not in the JSON, except the ones tagged
+// `oxy:"optional"`, are reported too if reportMissing is set. Null values
+// match every type.
+func CheckSchema(
+	data json.RawMessage,
+	t reflect.Type,
+	path string,
+	reportMissing bool,
+) []oxylabs.Diagnostic {
+	c := &schemaCheck{reportMissing: reportMissing, reportKept: true, diagnostics: []oxylabs.Diagnostic{}}
+	c.check(data, t, path)
+
+	return c.diagnostics
+}
+
+type schemaCheck struct {
+	reportMissing bool
+	// reportKept reports the values that do not match their type but are
+	// kept by their decoding, e.g. the raw value of an oxylabs.Int.
+	reportKept  bool
+	diagnostics []oxylabs.Diagnostic
+}
+
+func (c *schemaCheck) add(kind oxylabs.DiagnosticKind, path string, expected string, got string) {
+	c.diagnostics = append(c.diagnostics, oxylabs.Diagnostic{
+		Kind:     kind,
+		Path:     path,
+		Expected: expected,
+		Got:      got,
+	})
+}
+
+func (c *schemaCheck) check(data json.RawMessage, t reflect.Type, path string) {
+	got := jsonType(data)
+	if got == "null" {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == rawMessageType || t.Kind() == reflect.Interface {
+		return
+	}
+
+	// Types with their own decoding, e.g. time.Time, match the values they can
+	// be decoded from. The fields of objects decoded into structs are checked
+	// one by one instead, to report the fields that do not match.
+	if reflect.PointerTo(t).Implements(unmarshalerType) && (t.Kind() != reflect.Struct || got != "object") {
+		value := reflect.New(t).Interface()
+		if err := json.Unmarshal(data, value); err != nil || (c.reportKept && keptRaw(value)) {
+			c.add(oxylabs.TypeMismatch, path, typeName(t), got)
+		}
+		return
+	}
+
+	matches := true
+	switch t.Kind() {
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if matches = json.Unmarshal(data, &fields) == nil && got == "object"; matches {
+			c.checkStruct(fields, t, path)
+		}
+	case reflect.Map:
+		var fields map[string]json.RawMessage
+		if matches = json.Unmarshal(data, &fields) == nil && got == "object"; matches {
+			for _, k := range sortedKeys(fields) {
+				c.check(fields[k], t.Elem(), path+"/"+pointerEscaper.Replace(k))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if matches = json.Unmarshal(data, &items) == nil; matches {
+			for i, item := range items {
+				c.check(item, t.Elem(), fmt.Sprintf("%s/%d", path, i))
+			}
+		}
+	case reflect.String:
+		matches = got == "string"
+	case reflect.Bool:
+		matches = got == "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		matches = got == "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		matches = got == "integer" && !bytes.HasPrefix(bytes.TrimSpace(data), []byte("-"))
+	case reflect.Float32, reflect.Float64:
+		matches = got == "integer" || got == "float"
+	}
+
+	if !matches {
+		c.add(oxylabs.TypeMismatch, path, typeName(t), got)
+	}
+}
+
+func (c *schemaCheck) checkStruct(fields map[string]json.RawMessage, t reflect.Type, path string) {
+	type structField struct {
+		reflect.StructField
+		name     string
+		optional bool
+	}
+
+	// Fields are matched case-insensitively, as by encoding/json.
+	known := map[string]structField{}
+	var order []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		key := strings.ToLower(name)
+		known[key] = structField{StructField: f, name: name, optional: f.Tag.Get("oxy") == "optional"}
+		order = append(order, key)
+	}
+
+	present := map[string]bool{}
+	for _, k := range sortedKeys(fields) {
+		fieldPath := path + "/" + pointerEscaper.Replace(k)
+		present[strings.ToLower(k)] = true
+
+		f, ok := known[strings.ToLower(k)]
+		if !ok {
+			c.add(oxylabs.UnknownField, fieldPath, "", jsonType(fields[k]))
+			continue
+		}
+		c.check(fields[k], f.Type, fieldPath)
+	}
+
+	if !c.reportMissing {
+		return
+	}
+	for _, key := range order {
+		if f := known[key]; !present[key] && !f.optional {
+			c.add(oxylabs.MissingField, path+"/"+pointerEscaper.Replace(f.name), typeName(f.Type), "")
+		}
+	}
+}
+
+// keptRaw reports whether the decoded value did not match its type and was
+// kept raw instead.
+func keptRaw(v interface{}) bool {
+	switch n := v.(type) {
+	case *oxylabs.Int:
+		return n.Raw != ""
+	case *oxylabs.Float:
+		return n.Raw != ""
+	case *oxylabs.Price:
+		return n.Raw != ""
+	}
+
+	return false
+}
+
+// jsonType returns the type of the JSON value, distinguishing integers and floats.
+func jsonType(data json.RawMessage) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return "null"
+	}
+
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	}
+	if bytes.ContainsAny(data, ".eE") {
+		return "float"
+	}
+
+	return "integer"
+}
+
+// typeName returns the name of the type, naming anonymous structs object.
+func typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeName(t.Elem())
+	case reflect.Slice:
+		if t != rawMessageType {
+			return "[]" + typeName(t.Elem())
+		}
+	case reflect.Map:
+		return "map[" + typeName(t.Key()) + "]" + typeName(t.Elem())
+	case reflect.Interface:
+		return "any"
+	case reflect.Struct:
+		if t.Name() == "" {
+			return "object"
+		}
+	}
+
+	return t.String()
+}
+
+func sortedKeys(fields map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// NullMismatches returns the JSON value with the values that do not match
+// their type in t set to null, so that it can be decoded into t. The JSON
+// value is returned as is if every value matches.
+func NullMismatches(data json.RawMessage, t reflect.Type) (json.RawMessage, error) {
+	// Values kept by their decoding are not set to null, so that they are
+	// not lost.
+	c := &schemaCheck{}
+	c.check(data, t, "")
+
+	var paths [][]string
+	for _, d := range c.diagnostics {
+		if d.Kind == oxylabs.TypeMismatch {
+			paths = append(paths, pointerTokens(d.Path))
+		}
+	}
+	if len(paths) == 0 {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	for _, tokens := range paths {
+		if len(tokens) == 0 {
+			return json.RawMessage("null"), nil
+		}
+		setNull(v, tokens)
+	}
+
+	return json.Marshal(v)
+}
+
+// pointerTokens returns the unescaped reference tokens of the JSON pointer.
+func pointerTokens(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens
+}
+
+// setNull sets the value referenced by the tokens to null.
+func setNull(v interface{}, tokens []string) {
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+		switch container := v.(type) {
+		case map[string]interface{}:
+			if last {
+				container[token] = nil
+				return
+			}
+			v = container[token]
+		case []interface{}:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(container) {
+				return
+			}
+			if last {
+				container[idx] = nil
+				return
+			}
+			v = container[idx]
+		default:
+			return
+		}
+	}
+}
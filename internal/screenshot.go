@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// pngSignature is the first bytes of every PNG file.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// DecodeScreenshot returns the PNG of a result of a request with the render
+// parameter set to png. The API returns the PNG base64 encoded in the content.
+func DecodeScreenshot(content string) ([]byte, error) {
+	if strings.HasPrefix(content, string(pngSignature)) {
+		return []byte(content), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding screenshot: %w", err)
+	}
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, fmt.Errorf("error decoding screenshot: content is not a png")
+	}
+
+	return data, nil
+}
+
+// DecodeScreenshotImage returns the image of the PNG in the content.
+func DecodeScreenshotImage(content string) (image.Image, error) {
+	data, err := DecodeScreenshot(content)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding screenshot: %w", err)
+	}
+
+	return img, nil
+}
+
+// SaveScreenshot writes the PNG in the content to the file at path.
+func SaveScreenshot(content string, path string) error {
+	data, err := DecodeScreenshot(content)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("error saving screenshot: %w", err)
+	}
+
+	return nil
+}
+
+// GetScreenshot downloads the png result type of the push-pull job.
+func (c *Client) GetScreenshot(
+	ctx context.Context,
+	jobID string,
+) ([]byte, error) {
+	req, err := NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/%s/results?type=png", c.BaseUrl, jobID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(
+		c.ApiCredentials.Username,
+		c.ApiCredentials.Password,
+	)
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing req: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+	}
+	if !bytes.HasPrefix(respBody, pngSignature) {
+		return nil, fmt.Errorf("job %s has no png result", jobID)
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,482 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Parameters handled by the SDK instead of being sent as they are.
+const (
+	paramContext             = "context"
+	paramParsingInstructions = "parsing_instructions"
+	paramLenientContext      = "lenient_context"
+	paramPollInterval        = "poll_interval"
+	paramBrowserInstructions = "browser_instructions"
+)
+
+// InputType is the payload parameter the input of a source is sent as.
+type InputType string
+
+const (
+	InputQuery InputType = "query"
+	InputUrl   InputType = "url"
+)
+
+// Param describes a query parameter accepted by a source.
+// Default is used when the parameter is not set.
+type Param struct {
+	Name    string
+	Type    ValueType
+	Default interface{}
+}
+
+// SourceSpec describes a source: the input it expects, the parameters and
+// context options it accepts with their defaults, and the rules its
+// requests are validated with.
+type SourceSpec struct {
+	Source oxylabs.Source
+	Input  InputType
+
+	// Host is the host the input URL must belong to. If set, the input
+	// is validated as a URL even if it is sent as the query parameter.
+	Host string
+
+	Params      []Param
+	ContextKeys []ContextKey
+
+	// Domains are the accepted values of the domain parameter.
+	// Any domain is accepted if empty.
+	Domains []oxylabs.Domain
+
+	// Validate checks the source specific rules of the set parameters
+	// and context options.
+	Validate func(params map[string]interface{}, ctx oxylabs.ContextOption) error
+}
+
+// Payload is a validated payload ready to be sent to the API.
+type Payload struct {
+	JSON         []byte
+	Parse        bool
+	CustomParser bool
+	PollInterval time.Duration
+}
+
+// Registry maps sources to their specs.
+type Registry map[oxylabs.Source]*SourceSpec
+
+// NewRegistry returns a Registry with the given specs.
+func NewRegistry(specs ...*SourceSpec) Registry {
+	registry := make(Registry, len(specs))
+	for _, spec := range specs {
+		registry[spec.Source] = spec
+	}
+
+	return registry
+}
+
+// registeredSources contains the specs of the sources of every API.
+var registeredSources = Registry{}
+
+// RegisterSources adds the specs to the sources of every API
+// and returns a Registry with the given specs.
+func RegisterSources(specs ...*SourceSpec) Registry {
+	registry := NewRegistry(specs...)
+	for source, spec := range registry {
+		registeredSources[source] = spec
+	}
+
+	return registry
+}
+
+// Sources returns the Registry of the sources of every API.
+func Sources() Registry {
+	return registeredSources
+}
+
+// Lookup returns the spec of the given source.
+func (r Registry) Lookup(source oxylabs.Source) (*SourceSpec, error) {
+	spec, ok := r[source]
+	if !ok {
+		return nil, fmt.Errorf("unsupported source: %s", source)
+	}
+
+	return spec, nil
+}
+
+// BuildPayload builds the payload of a request to the given source.
+func (r Registry) BuildPayload(
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (*Payload, error) {
+	spec, err := r.Lookup(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec.BuildPayload(input, params)
+}
+
+// BuildPayload sets the defaults, checks the validity of the input, parameters
+// and context options and marshals the payload of a request to the source.
+func (s *SourceSpec) BuildPayload(
+	input string,
+	params oxylabs.Params,
+) (*Payload, error) {
+	// Check validity of the input.
+	if s.Input == InputUrl || s.Host != "" {
+		if err := ValidateUrl(input, s.Host); err != nil {
+			return nil, err
+		}
+	}
+
+	// Separate the parameters handled by the SDK from the query parameters.
+	values := make(map[string]interface{})
+	ctx := make(oxylabs.ContextOption)
+	var instructions *map[string]interface{}
+	var browserInstructions []oxylabs.BrowserInstruction
+	var lenient bool
+	var pollInterval time.Duration
+	for name, value := range params {
+		var err error
+		switch name {
+		case paramContext:
+			ctx, err = contextFromParam(value)
+		case paramParsingInstructions:
+			instructions, err = instructionsFromParam(value)
+		case paramBrowserInstructions:
+			browserInstructions, err = browserInstructionsFromParam(value)
+		case paramLenientContext:
+			lenient, err = lenientFromParam(value)
+		case paramPollInterval:
+			pollInterval, err = pollIntervalFromParam(value)
+		default:
+			if !IsZero(value) {
+				values[name] = value
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check that the parameters are accepted by the source and have the expected types.
+	if err := s.coerceParams(values); err != nil {
+		return nil, err
+	}
+
+	// Check validity of the context options and set their defaults.
+	if err := ValidateContext(ctx, s.ContextKeys, lenient); err != nil {
+		return nil, err
+	}
+	replaced := make(map[string]bool)
+	for _, key := range s.ContextKeys {
+		if ctx[key.Name] == nil {
+			if key.Default != nil {
+				ctx[key.Name] = key.Default
+			}
+			continue
+		}
+		for _, name := range key.Replaces {
+			if values[name] != nil {
+				return nil, fmt.Errorf(
+					"%s parameters cannot be used together with %s context parameter",
+					strings.Join(key.Replaces, ", "),
+					key.Name,
+				)
+			}
+			replaced[name] = true
+		}
+	}
+
+	// Set defaults.
+	for _, param := range s.Params {
+		if param.Default != nil && values[param.Name] == nil && !replaced[param.Name] {
+			values[param.Name], _ = coerceValue(param.Default, param.Type)
+		}
+	}
+
+	// Check validity of parameters.
+	if err := s.checkParams(values); err != nil {
+		return nil, err
+	}
+	if instructions != nil {
+		if err := oxylabs.ValidateParseInstructions(instructions); err != nil {
+			return nil, fmt.Errorf("invalid parse instructions: %w", err)
+		}
+	}
+	if len(browserInstructions) > 0 {
+		if err := s.checkBrowserInstructions(browserInstructions, values); err != nil {
+			return nil, err
+		}
+	}
+	if s.Validate != nil {
+		if err := s.Validate(values, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":        s.Source,
+		string(s.Input): input,
+	}
+	for name, value := range values {
+		payload[name] = value
+	}
+
+	// Add the set context options to the payload.
+	SetContextPayload(payload, ctx, s.ContextKeys, lenient)
+
+	// Add custom parsing instructions to the payload if provided.
+	if instructions != nil {
+		payload["parse"] = true
+		payload[paramParsingInstructions] = instructions
+	}
+
+	// Add browser instructions to the payload if provided.
+	if len(browserInstructions) > 0 {
+		payload[paramBrowserInstructions] = browserInstructions
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+
+	parse, _ := values["parse"].(bool)
+
+	return &Payload{
+		JSON:         jsonPayload,
+		Parse:        parse || instructions != nil,
+		CustomParser: instructions != nil,
+		PollInterval: pollInterval,
+	}, nil
+}
+
+// coerceParams checks that every set parameter is accepted by the source
+// and converts it to the expected type in place.
+func (s *SourceSpec) coerceParams(values map[string]interface{}) error {
+	for _, name := range sortedNames(values) {
+		param, ok := s.findParam(name)
+		if !ok {
+			return &oxylabs.ValidationError{
+				Key:    name,
+				Value:  values[name],
+				Reason: fmt.Sprintf("unsupported parameter, accepted parameters are: %s", s.paramNames()),
+			}
+		}
+
+		value, ok := coerceValue(values[name], param.Type)
+		if !ok {
+			return &oxylabs.ValidationError{
+				Key:    name,
+				Value:  values[name],
+				Reason: fmt.Sprintf("expected value of type %s, got %T", param.Type, values[name]),
+			}
+		}
+		values[name] = value
+	}
+
+	return nil
+}
+
+// checkParams checks the rules shared by the parameters of every source.
+func (s *SourceSpec) checkParams(values map[string]interface{}) error {
+	if userAgent, ok := values["user_agent_type"].(string); ok &&
+		!oxylabs.IsUserAgentValid(oxylabs.UserAgent(userAgent)) {
+		return fmt.Errorf("invalid user agent parameter: %v", userAgent)
+	}
+
+	if render, ok := values["render"].(string); ok && !oxylabs.IsRenderValid(oxylabs.Render(render)) {
+		return fmt.Errorf("invalid render parameter: %v", render)
+	}
+
+	if domain, ok := values["domain"].(string); ok &&
+		len(s.Domains) > 0 && !InList(oxylabs.Domain(domain), s.Domains) {
+		return fmt.Errorf("invalid domain parameter: %s", domain)
+	}
+
+	for _, name := range []string{"start_page", "pages", "limit"} {
+		if n, ok := values[name].(int); ok && n <= 0 {
+			return fmt.Errorf("%s parameter must be greater than 0", name)
+		}
+	}
+
+	return nil
+}
+
+// checkBrowserInstructions checks that the source renders pages, that HTML
+// rendering is enabled and that the browser instructions are valid.
+func (s *SourceSpec) checkBrowserInstructions(
+	instructions []oxylabs.BrowserInstruction,
+	values map[string]interface{},
+) error {
+	if _, ok := s.findParam("render"); !ok {
+		return &oxylabs.ValidationError{
+			Key:    paramBrowserInstructions,
+			Value:  instructions,
+			Reason: fmt.Sprintf("not supported by the %s source", s.Source),
+		}
+	}
+	if render := values["render"]; render == nil || fmt.Sprint(render) != string(oxylabs.HTML) {
+		return &oxylabs.ValidationError{
+			Key:    paramBrowserInstructions,
+			Value:  instructions,
+			Reason: fmt.Sprintf("rendering must be enabled with the render parameter set to %s", oxylabs.HTML),
+		}
+	}
+
+	return oxylabs.ValidateBrowserInstructions(instructions)
+}
+
+// findParam returns the Param with the given name.
+func (s *SourceSpec) findParam(name string) (Param, bool) {
+	for _, param := range s.Params {
+		if param.Name == name {
+			return param, true
+		}
+	}
+
+	return Param{}, false
+}
+
+// paramNames returns a comma separated list of the parameter names.
+func (s *SourceSpec) paramNames() string {
+	if len(s.Params) == 0 {
+		return "none"
+	}
+
+	names := make([]string, 0, len(s.Params))
+	for _, param := range s.Params {
+		names = append(names, param.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// contextFromParam returns a copy of the context options of the context parameter,
+// given either as context modifiers or as a map of context options.
+func contextFromParam(value interface{}) (oxylabs.ContextOption, error) {
+	ctx := make(oxylabs.ContextOption)
+	switch v := value.(type) {
+	case nil:
+	case []func(oxylabs.ContextOption):
+		for _, modifier := range v {
+			modifier(ctx)
+		}
+	case oxylabs.ContextOption:
+		for name, option := range v {
+			ctx[name] = option
+		}
+	case map[string]interface{}:
+		for name, option := range v {
+			ctx[name] = option
+		}
+	default:
+		return nil, &oxylabs.ValidationError{
+			Key:    paramContext,
+			Value:  value,
+			Reason: fmt.Sprintf("expected context modifiers or a map of context options, got %T", value),
+		}
+	}
+
+	return ctx, nil
+}
+
+// instructionsFromParam returns the custom parsing instructions of the parsing_instructions parameter.
+func instructionsFromParam(value interface{}) (*map[string]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case *map[string]interface{}:
+		return v, nil
+	case map[string]interface{}:
+		return &v, nil
+	}
+
+	return nil, &oxylabs.ValidationError{
+		Key:    paramParsingInstructions,
+		Value:  value,
+		Reason: fmt.Sprintf("expected a map of parsing instructions, got %T", value),
+	}
+}
+
+// browserInstructionsFromParam returns the browser instructions of the
+// browser_instructions parameter, given either as a list of browser
+// instructions, as a builder or decoded from JSON.
+func browserInstructionsFromParam(value interface{}) ([]oxylabs.BrowserInstruction, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []oxylabs.BrowserInstruction:
+		return v, nil
+	case *oxylabs.BrowserInstructions:
+		return v.Build()
+	}
+
+	var instructions []oxylabs.BrowserInstruction
+	b, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(b, &instructions)
+	}
+	if err != nil {
+		return nil, &oxylabs.ValidationError{
+			Key:    paramBrowserInstructions,
+			Value:  value,
+			Reason: fmt.Sprintf("expected a list of browser instructions, got %T", value),
+		}
+	}
+
+	return instructions, nil
+}
+
+// lenientFromParam returns the value of the lenient_context parameter.
+func lenientFromParam(value interface{}) (bool, error) {
+	if value == nil {
+		return false, nil
+	}
+
+	lenient, ok := value.(bool)
+	if !ok {
+		return false, &oxylabs.ValidationError{
+			Key:    paramLenientContext,
+			Value:  value,
+			Reason: fmt.Sprintf("expected value of type bool, got %T", value),
+		}
+	}
+
+	return lenient, nil
+}
+
+// pollIntervalFromParam returns the value of the poll_interval parameter.
+func pollIntervalFromParam(value interface{}) (time.Duration, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	pollInterval, ok := value.(time.Duration)
+	if !ok {
+		return 0, &oxylabs.ValidationError{
+			Key:    paramPollInterval,
+			Value:  value,
+			Reason: fmt.Sprintf("expected value of type time.Duration, got %T", value),
+		}
+	}
+
+	return pollInterval, nil
+}
+
+// LastOpt returns the last provided options or empty options if none are provided.
+func LastOpt[T any](opts []*T) *T {
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		return opts[len(opts)-1]
+	}
+
+	return new(T)
+}
@@ -0,0 +1,215 @@
+package internal
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// ValueType is the expected type of a parameter or context option value.
+type ValueType string
+
+const (
+	ValueString     ValueType = "string"
+	ValueInt        ValueType = "int"
+	ValueBool       ValueType = "bool"
+	ValueIntList    ValueType = "[]int"
+	ValueStringMap  ValueType = "map[string]string"
+	ValueKeyValues  ValueType = "[]oxylabs.KeyValue"
+	ValuePageLimits ValueType = "[]oxylabs.PageLimit"
+	ValueAny        ValueType = "any"
+)
+
+// coerceValue converts the value to the given value type.
+// It returns false if the value cannot be converted.
+func coerceValue(value interface{}, valueType ValueType) (interface{}, bool) {
+	switch valueType {
+	case ValueString:
+		return coerceString(value)
+	case ValueInt:
+		return coerceInt(value)
+	case ValueBool:
+		v, ok := value.(bool)
+		return v, ok
+	case ValueIntList:
+		return coerceIntList(value)
+	case ValueStringMap:
+		return coerceStringMap(value)
+	case ValueKeyValues:
+		return coerceKeyValues(value)
+	case ValuePageLimits:
+		return coercePageLimits(value)
+	case ValueAny:
+		return value, true
+	}
+
+	return nil, false
+}
+
+// coerceString converts string values, including named string types, to string.
+func coerceString(value interface{}) (string, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+
+	return v.String(), true
+}
+
+// coerceInt converts integer values of any size, integral floats
+// and json.Number values to int.
+func coerceInt(value interface{}) (int, bool) {
+	if n, ok := value.(json.Number); ok {
+		i, err := n.Int64()
+		if err != nil || int64(int(i)) != i {
+			return 0, false
+		}
+		return int(i), true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		if int64(int(i)) != i {
+			return 0, false
+		}
+		return int(i), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := v.Uint()
+		if u > math.MaxInt {
+			return 0, false
+		}
+		return int(u), true
+	case reflect.Float32, reflect.Float64:
+		// float64(math.MaxInt) rounds up to 2^63, which is out of range,
+		// while float64(math.MinInt) is exactly -2^63.
+		f := v.Float()
+		if f != math.Trunc(f) || f < math.MinInt || f >= math.MaxInt {
+			return 0, false
+		}
+		return int(f), true
+	}
+
+	return 0, false
+}
+
+// coerceIntList converts slices and arrays of integer values to []int.
+func coerceIntList(value interface{}) ([]int, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	list := make([]int, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		n, ok := coerceInt(v.Index(i).Interface())
+		if !ok {
+			return nil, false
+		}
+		list = append(list, n)
+	}
+
+	return list, true
+}
+
+// coerceStringMap converts maps with string keys and string values to map[string]string.
+func coerceStringMap(value interface{}) (map[string]string, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	m := make(map[string]string, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		s, ok := coerceString(iter.Value().Interface())
+		if !ok {
+			return nil, false
+		}
+		m[iter.Key().String()] = s
+	}
+
+	return m, true
+}
+
+// coerceKeyValues converts []oxylabs.KeyValue values and lists of maps
+// with string "key" and "value" entries to []oxylabs.KeyValue.
+func coerceKeyValues(value interface{}) ([]oxylabs.KeyValue, bool) {
+	if v, ok := value.([]oxylabs.KeyValue); ok {
+		return v, true
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	list := make([]oxylabs.KeyValue, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		m, ok := coerceStringMap(v.Index(i).Interface())
+		if !ok {
+			return nil, false
+		}
+		if _, ok := m["key"]; !ok {
+			return nil, false
+		}
+		list = append(list, oxylabs.KeyValue{Key: m["key"], Value: m["value"]})
+	}
+
+	return list, true
+}
+
+// coercePageLimits converts []oxylabs.PageLimit values and lists of maps
+// with integer "page" and "limit" entries to []oxylabs.PageLimit.
+func coercePageLimits(value interface{}) ([]oxylabs.PageLimit, bool) {
+	if v, ok := value.([]oxylabs.PageLimit); ok {
+		return v, true
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	list := make([]oxylabs.PageLimit, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		m, ok := v.Index(i).Interface().(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		page, ok := coerceInt(m["page"])
+		if !ok {
+			return nil, false
+		}
+		limit, ok := coerceInt(m["limit"])
+		if !ok {
+			return nil, false
+		}
+		list = append(list, oxylabs.PageLimit{Page: page, Limit: limit})
+	}
+
+	return list, true
+}
+
+// isNil checks if the value is nil or a nil slice, map or pointer.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Pointer, reflect.Interface, reflect.Func:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// IsZero checks if the value is nil or the zero value of its type.
+func IsZero(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerceInt_FloatBounds(t *testing.T) {
+	for _, tc := range []struct {
+		value interface{}
+		want  int
+		ok    bool
+	}{
+		{float64(12), 12, true},
+		{12.5, 0, false},
+		{math.Ldexp(1, 62), 1 << 62, true},
+		{math.Ldexp(1, 63), 0, false},
+		{float64(math.MaxInt), 0, false},
+		{float64(math.MinInt), math.MinInt, true},
+		{-math.Ldexp(1, 64), 0, false},
+	} {
+		got, ok := coerceInt(tc.value)
+		assert.Equal(t, tc.ok, ok, "%v", tc.value)
+		assert.Equal(t, tc.want, got, "%v", tc.value)
+	}
+}
@@ -0,0 +1,273 @@
+package oxylabs
+
+import (
+	"fmt"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/xpath"
+)
+
+type BrowserInstructionType string
+
+const (
+	BrowserClick          BrowserInstructionType = "click"
+	BrowserInput          BrowserInstructionType = "input"
+	BrowserScroll         BrowserInstructionType = "scroll"
+	BrowserScrollToBottom BrowserInstructionType = "scroll_to_bottom"
+	BrowserWait           BrowserInstructionType = "wait"
+	BrowserWaitForElement BrowserInstructionType = "wait_for_element"
+	BrowserFetchResource  BrowserInstructionType = "fetch_resource"
+)
+
+type SelectorType string
+
+const (
+	SelectorXpath SelectorType = "xpath"
+	SelectorCss   SelectorType = "css"
+	SelectorText  SelectorType = "text"
+)
+
+// BrowserOnError is the action taken when a browser instruction fails.
+type BrowserOnError string
+
+const (
+	BrowserOnErrorError BrowserOnError = "error"
+	BrowserOnErrorSkip  BrowserOnError = "skip"
+)
+
+// MaxBrowserInstructionTimeout is the maximum timeout and wait time
+// of a browser instruction in seconds.
+const MaxBrowserInstructionTimeout = 60
+
+// Selector selects the element a browser instruction is performed on.
+type Selector struct {
+	Type  SelectorType `json:"type"`
+	Value string       `json:"value"`
+}
+
+// XpathSelector returns a Selector of the element matching the xpath expression.
+func XpathSelector(expr string) Selector {
+	return Selector{Type: SelectorXpath, Value: expr}
+}
+
+// CssSelector returns a Selector of the element matching the CSS selector.
+func CssSelector(selector string) Selector {
+	return Selector{Type: SelectorCss, Value: selector}
+}
+
+// TextSelector returns a Selector of the element containing the text.
+func TextSelector(text string) Selector {
+	return Selector{Type: SelectorText, Value: text}
+}
+
+// BrowserInstruction is an action performed by the browser while rendering
+// the page. Browser instructions can only be used with Render set to HTML.
+type BrowserInstruction struct {
+	Type      BrowserInstructionType `json:"type"`
+	Selector  *Selector              `json:"selector,omitempty" oxy:"optional"`
+	Value     string                 `json:"value,omitempty" oxy:"optional"`
+	X         int                    `json:"x,omitempty" oxy:"optional"`
+	Y         int                    `json:"y,omitempty" oxy:"optional"`
+	Filter    string                 `json:"filter,omitempty" oxy:"optional"`
+	TimeoutS  int                    `json:"timeout_s,omitempty" oxy:"optional"`
+	WaitTimeS int                    `json:"wait_time_s,omitempty" oxy:"optional"`
+	OnError   BrowserOnError         `json:"on_error,omitempty" oxy:"optional"`
+}
+
+// BrowserInstructions builds a list of browser instructions. The timeout,
+// wait time and error action apply to the last added instruction:
+//
+//	bi := oxylabs.NewBrowserInstructions().
+//		Input(oxylabs.CssSelector("input[name=q]"), "adidas").
+//		Click(oxylabs.XpathSelector("//button[@type='submit']")).Timeout(5).
+//		WaitForElement(oxylabs.CssSelector(".results")).OnError(oxylabs.BrowserOnErrorSkip)
+//
+//	instructions, err := bi.Build()
+type BrowserInstructions struct {
+	instructions []BrowserInstruction
+	// err is the first misuse of the builder, returned by Build.
+	err error
+}
+
+// NewBrowserInstructions returns an empty list of browser instructions.
+func NewBrowserInstructions() *BrowserInstructions {
+	return &BrowserInstructions{instructions: []BrowserInstruction{}}
+}
+
+func (b *BrowserInstructions) add(instruction BrowserInstruction) *BrowserInstructions {
+	b.instructions = append(b.instructions, instruction)
+	return b
+}
+
+// last returns the last instruction, or nil and records an error for Build
+// if there is none, as the option set by method cannot be applied.
+func (b *BrowserInstructions) last(method string) *BrowserInstruction {
+	if len(b.instructions) == 0 {
+		if b.err == nil {
+			b.err = fmt.Errorf("%s must follow a browser instruction", method)
+		}
+		return nil
+	}
+
+	return &b.instructions[len(b.instructions)-1]
+}
+
+// Click clicks the selected element.
+func (b *BrowserInstructions) Click(selector Selector) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserClick, Selector: &selector})
+}
+
+// Input types the value into the selected element.
+func (b *BrowserInstructions) Input(selector Selector, value string) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserInput, Selector: &selector, Value: value})
+}
+
+// Scroll scrolls the page by the given number of pixels.
+func (b *BrowserInstructions) Scroll(x int, y int) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserScroll, X: x, Y: y})
+}
+
+// ScrollToBottom scrolls to the bottom of the page.
+func (b *BrowserInstructions) ScrollToBottom() *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserScrollToBottom})
+}
+
+// Wait waits for the given number of seconds.
+func (b *BrowserInstructions) Wait(seconds int) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserWait, WaitTimeS: seconds})
+}
+
+// WaitForElement waits until the selected element appears.
+func (b *BrowserInstructions) WaitForElement(selector Selector) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserWaitForElement, Selector: &selector})
+}
+
+// FetchResource returns the first resource loaded by the page whose URL matches
+// the regex filter instead of the page. It must be the last instruction.
+func (b *BrowserInstructions) FetchResource(filter string) *BrowserInstructions {
+	return b.add(BrowserInstruction{Type: BrowserFetchResource, Filter: filter})
+}
+
+// Timeout sets the time in seconds to wait for the last instruction to complete.
+func (b *BrowserInstructions) Timeout(seconds int) *BrowserInstructions {
+	if last := b.last("Timeout"); last != nil {
+		last.TimeoutS = seconds
+	}
+	return b
+}
+
+// WaitTime sets the time in seconds to wait after the last instruction.
+func (b *BrowserInstructions) WaitTime(seconds int) *BrowserInstructions {
+	if last := b.last("WaitTime"); last != nil {
+		last.WaitTimeS = seconds
+	}
+	return b
+}
+
+// OnError sets the action taken when the last instruction fails.
+func (b *BrowserInstructions) OnError(action BrowserOnError) *BrowserInstructions {
+	if last := b.last("OnError"); last != nil {
+		last.OnError = action
+	}
+	return b
+}
+
+// Build returns the browser instructions after checking their validity.
+func (b *BrowserInstructions) Build() ([]BrowserInstruction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := ValidateBrowserInstructions(b.instructions); err != nil {
+		return nil, err
+	}
+
+	return b.instructions, nil
+}
+
+// ValidateBrowserInstructions checks the validity of the browser instructions.
+func ValidateBrowserInstructions(instructions []BrowserInstruction) error {
+	for i, instruction := range instructions {
+		if err := validateBrowserInstruction(instruction); err != nil {
+			return fmt.Errorf("invalid browser instruction %d (%s): %w", i, instruction.Type, err)
+		}
+		if instruction.Type == BrowserFetchResource && i != len(instructions)-1 {
+			return fmt.Errorf("invalid browser instruction %d (%s): must be the last instruction", i, instruction.Type)
+		}
+	}
+
+	return nil
+}
+
+func validateBrowserInstruction(instruction BrowserInstruction) error {
+	switch instruction.Type {
+	case BrowserClick, BrowserWaitForElement:
+		if err := validateSelector(instruction.Selector); err != nil {
+			return err
+		}
+	case BrowserInput:
+		if err := validateSelector(instruction.Selector); err != nil {
+			return err
+		}
+		if instruction.Value == "" {
+			return fmt.Errorf("value cannot be empty")
+		}
+	case BrowserScroll:
+		if instruction.X == 0 && instruction.Y == 0 {
+			return fmt.Errorf("x or y must be set")
+		}
+	case BrowserScrollToBottom:
+	case BrowserWait:
+		if instruction.WaitTimeS <= 0 {
+			return fmt.Errorf("wait_time_s must be greater than 0")
+		}
+	case BrowserFetchResource:
+		if instruction.Filter == "" {
+			return fmt.Errorf("filter cannot be empty")
+		}
+		if err := checkRegex(instruction.Filter).err; err != nil {
+			return fmt.Errorf("invalid filter regex %q: %w", instruction.Filter, err)
+		}
+	default:
+		return fmt.Errorf("unknown type")
+	}
+
+	if instruction.TimeoutS < 0 || instruction.TimeoutS > MaxBrowserInstructionTimeout {
+		return fmt.Errorf("timeout_s must be between 0 and %d", MaxBrowserInstructionTimeout)
+	}
+	if instruction.WaitTimeS < 0 || instruction.WaitTimeS > MaxBrowserInstructionTimeout {
+		return fmt.Errorf("wait_time_s must be between 0 and %d", MaxBrowserInstructionTimeout)
+	}
+
+	switch instruction.OnError {
+	case "", BrowserOnErrorError, BrowserOnErrorSkip:
+	default:
+		return fmt.Errorf("on_error must be %s or %s", BrowserOnErrorError, BrowserOnErrorSkip)
+	}
+
+	return nil
+}
+
+func validateSelector(selector *Selector) error {
+	if selector == nil {
+		return fmt.Errorf("selector must be set")
+	}
+	if selector.Value == "" {
+		return fmt.Errorf("selector value cannot be empty")
+	}
+
+	switch selector.Type {
+	case SelectorXpath:
+		if _, err := xpath.Compile(selector.Value); err != nil {
+			return fmt.Errorf("invalid xpath selector %q: %w", selector.Value, err)
+		}
+	case SelectorCss:
+		if _, err := cascadia.Compile(selector.Value); err != nil {
+			return fmt.Errorf("invalid css selector %q: %w", selector.Value, err)
+		}
+	case SelectorText:
+	default:
+		return fmt.Errorf("selector type must be %s, %s or %s", SelectorXpath, SelectorCss, SelectorText)
+	}
+
+	return nil
+}
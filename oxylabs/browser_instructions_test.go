@@ -0,0 +1,100 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrowserInstructionsBuilder(t *testing.T) {
+	instructions, err := NewBrowserInstructions().
+		Input(CssSelector("input[name=q]"), "adidas").
+		Click(XpathSelector("//button[@type='submit']")).Timeout(5).WaitTime(2).
+		WaitForElement(TextSelector("Results")).OnError(BrowserOnErrorSkip).
+		Scroll(0, 500).
+		ScrollToBottom().
+		Wait(1).
+		FetchResource(`/api/products\?page=\d+`).
+		Build()
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(instructions)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "input", "selector": {"type": "css", "value": "input[name=q]"}, "value": "adidas"},
+		{"type": "click", "selector": {"type": "xpath", "value": "//button[@type='submit']"}, "timeout_s": 5, "wait_time_s": 2},
+		{"type": "wait_for_element", "selector": {"type": "text", "value": "Results"}, "on_error": "skip"},
+		{"type": "scroll", "y": 500},
+		{"type": "scroll_to_bottom"},
+		{"type": "wait", "wait_time_s": 1},
+		{"type": "fetch_resource", "filter": "/api/products\\?page=\\d+"}
+	]`, string(b))
+}
+
+func TestBrowserInstructionsBuilder_OptionWithoutInstruction(t *testing.T) {
+	_, err := NewBrowserInstructions().Timeout(5).ScrollToBottom().Build()
+	assert.EqualError(t, err, "Timeout must follow a browser instruction")
+}
+
+func TestValidateBrowserInstructions(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions []BrowserInstruction
+		err          string
+	}{
+		{
+			name:         "unknown type",
+			instructions: []BrowserInstruction{{Type: "hover"}},
+			err:          "invalid browser instruction 0 (hover): unknown type",
+		},
+		{
+			name:         "missing selector",
+			instructions: []BrowserInstruction{{Type: BrowserClick}},
+			err:          "invalid browser instruction 0 (click): selector must be set",
+		},
+		{
+			name:         "invalid xpath selector",
+			instructions: []BrowserInstruction{{Type: BrowserClick, Selector: &Selector{Type: SelectorXpath, Value: "//div["}}},
+			err:          `invalid browser instruction 0 (click): invalid xpath selector "//div["`,
+		},
+		{
+			name:         "invalid selector type",
+			instructions: []BrowserInstruction{{Type: BrowserClick, Selector: &Selector{Type: "id", Value: "main"}}},
+			err:          "invalid browser instruction 0 (click): selector type must be xpath, css or text",
+		},
+		{
+			name:         "empty input",
+			instructions: []BrowserInstruction{{Type: BrowserInput, Selector: &Selector{Type: SelectorCss, Value: "input"}}},
+			err:          "invalid browser instruction 0 (input): value cannot be empty",
+		},
+		{
+			name:         "timeout too long",
+			instructions: []BrowserInstruction{{Type: BrowserScrollToBottom, TimeoutS: 61}},
+			err:          "invalid browser instruction 0 (scroll_to_bottom): timeout_s must be between 0 and 60",
+		},
+		{
+			name:         "wait without time",
+			instructions: []BrowserInstruction{{Type: BrowserWait}},
+			err:          "invalid browser instruction 0 (wait): wait_time_s must be greater than 0",
+		},
+		{
+			name:         "invalid on error",
+			instructions: []BrowserInstruction{{Type: BrowserScroll, Y: 100, OnError: "ignore"}},
+			err:          "invalid browser instruction 0 (scroll): on_error must be error or skip",
+		},
+		{
+			name: "fetch resource not last",
+			instructions: []BrowserInstruction{
+				{Type: BrowserFetchResource, Filter: "api"},
+				{Type: BrowserScrollToBottom},
+			},
+			err: "invalid browser instruction 0 (fetch_resource): must be the last instruction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateBrowserInstructions(tt.instructions), tt.err)
+		})
+	}
+}
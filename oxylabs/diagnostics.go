@@ -0,0 +1,44 @@
+package oxylabs
+
+import "fmt"
+
+// DiagnosticKind is the kind of difference between a response and its model.
+type DiagnosticKind string
+
+const (
+	// UnknownField is a field of the response that is not in the model.
+	UnknownField DiagnosticKind = "unknown_field"
+	// TypeMismatch is a value of the response that does not match the type
+	// of its field, e.g. a float for an int field.
+	TypeMismatch DiagnosticKind = "type_mismatch"
+	// MissingField is a field of the model that is not in the response.
+	MissingField DiagnosticKind = "missing_field"
+)
+
+// Diagnostic describes a difference between the JSON of a response and the
+// struct it is decoded into, reported by the strict decode mode. Path is the
+// JSON pointer of the value, e.g. /results/0/content/price_initial.
+type Diagnostic struct {
+	Kind     DiagnosticKind
+	Path     string
+	Expected string
+	Got      string
+}
+
+func (d Diagnostic) String() string {
+	switch d.Kind {
+	case UnknownField:
+		return fmt.Sprintf("%s: unknown field of type %s", d.Path, d.Got)
+	case TypeMismatch:
+		return fmt.Sprintf("%s: type mismatch, expected %s, got %s", d.Path, d.Expected, d.Got)
+	case MissingField:
+		return fmt.Sprintf("%s: missing field of type %s", d.Path, d.Expected)
+	}
+
+	return fmt.Sprintf("%s: %s", d.Path, d.Kind)
+}
+
+// DiagnosticHook receives the diagnostics of a response decoded in the
+// strict decode mode, e.g. to send them to a logger. It is only called
+// when there are diagnostics.
+type DiagnosticHook func(diagnostics []Diagnostic)
@@ -0,0 +1,37 @@
+package oxylabs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidationError is returned when a parameter or context option
+// has an unsupported or invalid value.
+type ValidationError struct {
+	Key    string
+	Value  interface{}
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s parameter: %s", e.Key, e.Reason)
+}
+
+// ParseInstructionsError is returned when the parse instructions are invalid.
+// Path is the JSON pointer of the invalid element, e.g. /products/_items/price/_fns/1.
+type ParseInstructionsError struct {
+	Path string
+	Err  error
+}
+
+func newParseInstructionsError(path string, reason string) *ParseInstructionsError {
+	return &ParseInstructionsError{Path: path, Err: errors.New(reason)}
+}
+
+func (e *ParseInstructionsError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e *ParseInstructionsError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,215 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// JobStatus is the status of a job.
+type JobStatus string
+
+const (
+	JobStatusPending JobStatus = "pending"
+	JobStatusDone    JobStatus = "done"
+	JobStatusFaulted JobStatus = "faulted"
+)
+
+// Finished reports whether the job is done or faulted.
+func (s JobStatus) Finished() bool {
+	return s == JobStatusDone || s == JobStatusFaulted
+}
+
+// Rels of the links of a job.
+const (
+	JobLinkSelf    = "self"
+	JobLinkResults = "results"
+)
+
+// JobLink is a link of a job to a related resource, e.g. its results.
+type JobLink struct {
+	Rel    string `json:"rel"`
+	Href   string `json:"href"`
+	Method string `json:"method"`
+}
+
+// JobLinks are the links of a job.
+type JobLinks []JobLink
+
+// Get returns the first link with the given rel.
+func (l JobLinks) Get(rel string) (JobLink, bool) {
+	for _, link := range l {
+		if link.Rel == rel {
+			return link, true
+		}
+	}
+
+	return JobLink{}, false
+}
+
+// JobContext is a context option of a job.
+type JobContext struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// Job is the job of a response, as returned by the API when submitting
+// or polling a query.
+type Job struct {
+	CallbackUrl         string                 `json:"callback_url"`
+	ClientID            int                    `json:"client_id"`
+	Context             []JobContext           `json:"context,omitempty" oxy:"optional"`
+	CreatedAt           Timestamp              `json:"created_at"`
+	Domain              string                 `json:"domain"`
+	GeoLocation         string                 `json:"geo_location"`
+	ID                  string                 `json:"id"`
+	Limit               int                    `json:"limit"`
+	Locale              string                 `json:"locale"`
+	Pages               int                    `json:"pages"`
+	Parse               bool                   `json:"parse"`
+	ParserType          string                 `json:"parser_type"`
+	ParsingInstructions map[string]interface{} `json:"parsing_instructions"`
+	BrowserInstructions []BrowserInstruction   `json:"browser_instructions"`
+	Render              Render                 `json:"render"`
+	Url                 string                 `json:"url"`
+	Query               string                 `json:"query"`
+	Source              Source                 `json:"source"`
+	StartPage           int                    `json:"start_page"`
+	Status              JobStatus              `json:"status"`
+	StorageType         string                 `json:"storage_type"`
+	StorageUrl          string                 `json:"storage_url"`
+	Subdomain           string                 `json:"subdomain"`
+	ContentEncoding     string                 `json:"content_encoding"`
+	UpdatedAt           Timestamp              `json:"updated_at"`
+	UserAgentType       UserAgent              `json:"user_agent_type"`
+	SessionInfo         map[string]interface{} `json:"session_info"`
+	Statuses            JobStatuses            `json:"statuses"`
+	ClientNotes         string                 `json:"client_notes"`
+	Links               JobLinks               `json:"_links,omitempty" oxy:"optional"`
+
+	// Extra contains the fields of the job that are not mapped and the
+	// fields whose value does not match their type, as returned by the API.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// jobFields are the indexes of the fields of Job by lower case JSON name.
+var jobFields = func() map[string]int {
+	fields := map[string]int{}
+	t := reflect.TypeOf(Job{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "-" {
+			fields[strings.ToLower(name)] = i
+		}
+	}
+
+	return fields
+}()
+
+// UnmarshalJSON decodes the job field by field, so that a change of the type
+// of a field by the API does not fail the decoding of the job, e.g. while
+// polling it. The fields that do not match their type are left unset and
+// kept in Extra, with the fields that are not mapped.
+func (j *Job) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*j = Job{}
+	v := reflect.ValueOf(j).Elem()
+	for name, value := range fields {
+		// Fields are matched case-insensitively, as by encoding/json.
+		if i, ok := jobFields[strings.ToLower(name)]; ok {
+			field := v.Field(i)
+			if err := json.Unmarshal(value, field.Addr().Interface()); err == nil {
+				continue
+			}
+			field.SetZero()
+		}
+
+		if j.Extra == nil {
+			j.Extra = map[string]json.RawMessage{}
+		}
+		j.Extra[name] = value
+	}
+
+	return nil
+}
+
+// Link returns the first link of the job with the given rel, e.g. JobLinkResults.
+func (j *Job) Link(rel string) (JobLink, bool) {
+	return j.Links.Get(rel)
+}
+
+// QueueTime returns the time the job spent pending, from its creation until
+// its first status other than pending.
+func (j *Job) QueueTime() (time.Duration, bool) {
+	return j.Statuses.QueueTime(j.CreatedAt.Time)
+}
+
+// ProcessingDuration returns the time between the first status of the job
+// other than pending and its done or faulted status.
+func (j *Job) ProcessingDuration() (time.Duration, bool) {
+	return j.Statuses.ProcessingDuration()
+}
+
+// JobStatusChange is an entry of the status history of a job.
+type JobStatusChange struct {
+	Status    JobStatus `json:"status"`
+	CreatedAt Timestamp `json:"created_at"`
+}
+
+// JobStatuses is the status history of a job, from the oldest status.
+type JobStatuses []JobStatusChange
+
+// At returns the time at which the job first reached one of the statuses.
+func (s JobStatuses) At(statuses ...JobStatus) (time.Time, bool) {
+	for _, change := range s {
+		for _, status := range statuses {
+			if change.Status == status && !change.CreatedAt.IsZero() {
+				return change.CreatedAt.Time, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// started returns the time at which the job left the pending status.
+func (s JobStatuses) started() (time.Time, bool) {
+	for _, change := range s {
+		if change.Status != JobStatusPending && !change.CreatedAt.IsZero() {
+			return change.CreatedAt.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// QueueTime returns the time the job created at the given time spent pending,
+// until its first status other than pending.
+func (s JobStatuses) QueueTime(createdAt time.Time) (time.Duration, bool) {
+	started, ok := s.started()
+	if !ok || createdAt.IsZero() {
+		return 0, false
+	}
+
+	return started.Sub(createdAt), true
+}
+
+// ProcessingDuration returns the time between the first status of the job
+// other than pending and its done or faulted status.
+func (s JobStatuses) ProcessingDuration() (time.Duration, bool) {
+	started, ok := s.started()
+	if !ok {
+		return 0, false
+	}
+	finished, ok := s.At(JobStatusDone, JobStatusFaulted)
+	if !ok {
+		return 0, false
+	}
+
+	return finished.Sub(started), true
+}
@@ -0,0 +1,93 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobStatuses_Durations(t *testing.T) {
+	var statuses JobStatuses
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"status": "pending", "created_at": "2024-02-14 10:58:35"},
+		{"status": "processing", "created_at": "2024-02-14 10:58:37"},
+		{"status": "done", "created_at": "2024-02-14 10:58:42"}
+	]`), &statuses))
+
+	createdAt, _ := ParseTimestamp("2024-02-14 10:58:35")
+	queue, ok := statuses.QueueTime(createdAt.Time)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, queue)
+
+	processing, ok := statuses.ProcessingDuration()
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, processing)
+
+	done, ok := statuses.At("done")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 2, 14, 10, 58, 42, 0, time.UTC), done)
+}
+
+func TestJobStatuses_Pending(t *testing.T) {
+	statuses := JobStatuses{{Status: "pending"}}
+
+	_, ok := statuses.QueueTime(time.Now())
+	assert.False(t, ok)
+	_, ok = statuses.ProcessingDuration()
+	assert.False(t, ok)
+}
+
+func TestJob_Unmarshal(t *testing.T) {
+	var job Job
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"id": "7163627465373489153",
+		"source": "google_search",
+		"status": "done",
+		"render": "html",
+		"geo_location": null,
+		"user_agent_type": "desktop",
+		"browser_instructions": [{"type": "click", "selector": {"type": "css", "value": "button"}}],
+		"_links": [
+			{"rel": "self", "href": "http://data.oxylabs.io/v1/queries/7163627465373489153", "method": "GET"},
+			{"rel": "results", "href": "http://data.oxylabs.io/v1/queries/7163627465373489153/results", "method": "GET"}
+		]
+	}`), &job))
+
+	assert.Equal(t, JobStatusDone, job.Status)
+	assert.True(t, job.Status.Finished())
+	assert.Equal(t, GoogleSearch, job.Source)
+	assert.Equal(t, HTML, job.Render)
+	assert.Equal(t, "button", job.BrowserInstructions[0].Selector.Value)
+
+	link, ok := job.Link(JobLinkResults)
+	assert.True(t, ok)
+	assert.Equal(t, "http://data.oxylabs.io/v1/queries/7163627465373489153/results", link.Href)
+	_, ok = job.Link("results-html")
+	assert.False(t, ok)
+}
+
+func TestJob_UnmarshalKeepsMismatchedFields(t *testing.T) {
+	var job Job
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"id": "123",
+		"status": "done",
+		"client_id": "42",
+		"render": 5,
+		"created_at": {},
+		"new_field": true
+	}`), &job))
+
+	assert.Equal(t, "123", job.ID)
+	assert.Equal(t, JobStatusDone, job.Status)
+	assert.Zero(t, job.ClientID)
+	assert.Empty(t, job.Render)
+	assert.True(t, job.CreatedAt.IsZero())
+	assert.Equal(t, map[string]json.RawMessage{
+		"client_id":  json.RawMessage(`"42"`),
+		"render":     json.RawMessage(`5`),
+		"created_at": json.RawMessage(`{}`),
+		"new_field":  json.RawMessage(`true`),
+	}, job.Extra)
+}
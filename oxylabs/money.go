@@ -0,0 +1,250 @@
+package oxylabs
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Decimal is an exact decimal number, Units × 10^-Scale,
+// e.g. 1299.00 is Decimal{Units: 129900, Scale: 2}.
+type Decimal struct {
+	Units int64
+	Scale int
+}
+
+// ParseDecimal parses a decimal number with a dot as the decimal separator,
+// e.g. "1299.00".
+func ParseDecimal(s string) (Decimal, error) {
+	intPart, fracPart, _ := strings.Cut(strings.TrimSpace(s), ".")
+	units, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil || strings.HasPrefix(fracPart, "-") || strings.HasPrefix(fracPart, "+") {
+		return Decimal{}, fmt.Errorf("%q is not a decimal number", s)
+	}
+
+	return Decimal{Units: units, Scale: len(fracPart)}, nil
+}
+
+func (d Decimal) String() string {
+	return d.Rat().FloatString(max(d.Scale, 0))
+}
+
+// Rat returns the decimal as a rational number, for exact arithmetic.
+func (d Decimal) Rat() *big.Rat {
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(d.Scale, 0))), nil)
+
+	return new(big.Rat).SetFrac(big.NewInt(d.Units), denom)
+}
+
+// Float64 returns the nearest float64 value of the decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares the decimals and returns -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	return d.Rat().Cmp(o.Rat())
+}
+
+// Money is a price: a decimal amount and the ISO 4217 code of its currency,
+// which is empty if the currency is unknown.
+type Money struct {
+	Amount   Decimal
+	Currency string
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+
+	return m.Amount.String() + " " + m.Currency
+}
+
+// MoneyRange is a price range, e.g. "$12.99–$15.99". Min and Max are equal
+// for a single price.
+type MoneyRange struct {
+	Min Money
+	Max Money
+}
+
+// ParseMoney parses the first amount of a localized price string, e.g.
+// "1.299,00 €", like the amount_from_string function of parse instructions.
+// The currency is detected from its ISO code or symbol in the string.
+func ParseMoney(s string) (Money, error) {
+	amounts, err := findAmounts(s)
+	if err != nil {
+		return Money{}, err
+	}
+	if len(amounts) == 0 {
+		return Money{}, fmt.Errorf("no amount found in %q", s)
+	}
+
+	return Money{Amount: amounts[0], Currency: CurrencyCode(s)}, nil
+}
+
+// ParseMoneyRange parses the amounts of a localized price range string,
+// e.g. "$12.99–$15.99", like the amount_range_from_string function of parse
+// instructions. The first amount is the minimum and the last the maximum.
+func ParseMoneyRange(s string) (MoneyRange, error) {
+	amounts, err := findAmounts(s)
+	if err != nil {
+		return MoneyRange{}, err
+	}
+	if len(amounts) == 0 {
+		return MoneyRange{}, fmt.Errorf("no amount found in %q", s)
+	}
+
+	currency := CurrencyCode(s)
+	return MoneyRange{
+		Min: Money{Amount: amounts[0], Currency: currency},
+		Max: Money{Amount: amounts[len(amounts)-1], Currency: currency},
+	}, nil
+}
+
+// amountPattern matches amounts with optional thousands separators, which
+// are followed by groups of three digits, and an optional decimal part.
+var amountPattern = regexp.MustCompile(`(\d+(?:[.,'’\x{00a0}\x{202f} ]\d{3})*)(?:([.,])(\d+))?`)
+
+// findAmounts returns the amounts of the localized string. A single dot or
+// comma followed by three digits is a thousands separator, as in "1,299" or
+// "1.299", unless the integer part is zero.
+func findAmounts(s string) ([]Decimal, error) {
+	amounts := []Decimal{}
+	for _, m := range amountPattern.FindAllStringSubmatchIndex(s, -1) {
+		match := s[m[0]:m[1]]
+		if r, _ := utf8.DecodeRuneInString(s[m[1]:]); r >= '0' && r <= '9' {
+			return nil, fmt.Errorf("%q is not an amount", match+string(r))
+		}
+
+		intPart, fracPart := s[m[2]:m[3]], ""
+		if m[6] >= 0 {
+			fracPart = s[m[6]:m[7]]
+		}
+
+		// Without a decimal part, the last separator is a decimal one if the
+		// separators differ, as in "1.299,000", or the integer part is zero.
+		if fracPart == "" {
+			seps := strings.FieldsFunc(intPart, func(r rune) bool { return r >= '0' && r <= '9' })
+			if n := len(seps); n > 0 && (seps[n-1] == "." || seps[n-1] == ",") {
+				last := strings.LastIndex(intPart, seps[n-1])
+				if (n > 1 && seps[0] != seps[n-1]) || intPart[:last] == "0" {
+					intPart, fracPart = intPart[:last], intPart[last+1:]
+				}
+			}
+		}
+
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, intPart)
+
+		amount, err := ParseDecimal(digits + "." + fracPart)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an amount", match)
+		}
+		amounts = append(amounts, amount)
+	}
+
+	return amounts, nil
+}
+
+var (
+	currencyCodes = map[string]bool{
+		"AED": true, "ARS": true, "AUD": true, "BGN": true, "BRL": true, "CAD": true, "CHF": true,
+		"CLP": true, "CNY": true, "COP": true, "CZK": true, "DKK": true, "EGP": true, "EUR": true,
+		"GBP": true, "HKD": true, "HUF": true, "IDR": true, "ILS": true, "INR": true, "JPY": true,
+		"KRW": true, "MXN": true, "MYR": true, "NOK": true, "NZD": true, "PEN": true, "PHP": true,
+		"PLN": true, "RON": true, "RUB": true, "SAR": true, "SEK": true, "SGD": true, "THB": true,
+		"TRY": true, "TWD": true, "UAH": true, "USD": true, "VND": true, "ZAR": true,
+	}
+	currencySymbols = map[string]string{
+		"$": "USD", "US$": "USD", "€": "EUR", "£": "GBP", "¥": "JPY", "₹": "INR", "₩": "KRW",
+		"₽": "RUB", "₺": "TRY", "₪": "ILS", "₱": "PHP", "฿": "THB", "₫": "VND", "₴": "UAH",
+		"R$": "BRL", "C$": "CAD", "CA$": "CAD", "A$": "AUD", "AU$": "AUD", "NZ$": "NZD",
+		"HK$": "HKD", "S$": "SGD", "MX$": "MXN", "zł": "PLN", "Kč": "CZK",
+	}
+	// currencySymbolsByLength lists the symbols from the longest, so that
+	// e.g. "R$" is matched before "$".
+	currencySymbolsByLength = func() []string {
+		symbols := make([]string, 0, len(currencySymbols))
+		for symbol := range currencySymbols {
+			symbols = append(symbols, symbol)
+		}
+		sort.Slice(symbols, func(i, j int) bool {
+			if len(symbols[i]) != len(symbols[j]) {
+				return len(symbols[i]) > len(symbols[j])
+			}
+			return symbols[i] < symbols[j]
+		})
+
+		return symbols
+	}()
+	currencyCodePattern = regexp.MustCompile(`(?:^|[^A-Za-z])([A-Z]{3})(?:[^A-Za-z]|$)`)
+)
+
+// CurrencyCode returns the ISO 4217 code of the currency in the string, given
+// either as a code, e.g. "EUR", or as a symbol, e.g. "€". It returns an empty
+// string if the currency is unknown.
+func CurrencyCode(s string) string {
+	for _, m := range currencyCodePattern.FindAllStringSubmatch(s, -1) {
+		if currencyCodes[m[1]] {
+			return m[1]
+		}
+	}
+	for _, symbol := range currencySymbolsByLength {
+		if strings.Contains(s, symbol) {
+			return currencySymbols[symbol]
+		}
+	}
+
+	return ""
+}
+
+// Price is a numeric price field of a parsed response. It is decoded like
+// Float and can be read as Money in the currency of the response.
+type Price struct {
+	Float
+}
+
+// NewPrice returns a valid Price with the given value.
+func NewPrice(v float64) Price {
+	return Price{Float: NewFloat(v)}
+}
+
+// Money returns the price in the currency, given either as an ISO code or as
+// a symbol. The amount is the shortest decimal representation of the value,
+// e.g. 19.99 rather than 19.989999999999998.
+func (p Price) Money(currency string) (Money, error) {
+	if !p.Valid {
+		return Money{}, fmt.Errorf("price is not set")
+	}
+
+	amount, err := ParseDecimal(strconv.FormatFloat(p.Value, 'f', -1, 64))
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: CurrencyCode(currency)}, nil
+}
+
+// PriceText is a price field of a parsed response given as text, e.g.
+// "$12.99" or "1.299,00 €".
+type PriceText string
+
+// Money parses the price with ParseMoney.
+func (p PriceText) Money() (Money, error) {
+	return ParseMoney(string(p))
+}
+
+// MoneyRange parses the price range with ParseMoneyRange.
+func (p PriceText) MoneyRange() (MoneyRange, error) {
+	return ParseMoneyRange(string(p))
+}
@@ -0,0 +1,56 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	for s, want := range map[string]string{
+		"$12.99":         "12.99 USD",
+		"1.299,00 €":     "1299.00 EUR",
+		"1,299.00 USD":   "1299.00 USD",
+		"£1,299":         "1299 GBP",
+		"1 299,50 zł":    "1299.50 PLN",
+		"12,5 EUR":       "12.5 EUR",
+		"R$ 0,125":       "0.125 BRL",
+		"1.299.000,5 kr": "1299000.5",
+		"CHF 1'299.90":   "1299.90 CHF",
+		"Now 19.99":      "19.99",
+	} {
+		money, err := ParseMoney(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, want, money.String(), s)
+	}
+}
+
+func TestParseMoney_Invalid(t *testing.T) {
+	_, err := ParseMoney("free")
+	assert.EqualError(t, err, `no amount found in "free"`)
+}
+
+func TestParseMoneyRange(t *testing.T) {
+	prices, err := ParseMoneyRange("$12.99–$15.99")
+	assert.NoError(t, err)
+	assert.Equal(t, Money{Amount: Decimal{Units: 1299, Scale: 2}, Currency: "USD"}, prices.Min)
+	assert.Equal(t, Money{Amount: Decimal{Units: 1599, Scale: 2}, Currency: "USD"}, prices.Max)
+
+	prices, err = ParseMoneyRange("9,99 €")
+	assert.NoError(t, err)
+	assert.Equal(t, prices.Min, prices.Max)
+}
+
+func TestPrice_Money(t *testing.T) {
+	money, err := NewPrice(1299).Money("€")
+	assert.NoError(t, err)
+	assert.Equal(t, "1299 EUR", money.String())
+
+	money, err = NewPrice(19.99).Money("USD")
+	assert.NoError(t, err)
+	assert.Equal(t, Decimal{Units: 1999, Scale: 2}, money.Amount)
+	assert.Equal(t, 0, money.Amount.Cmp(Decimal{Units: 19990, Scale: 3}))
+
+	_, err = Price{}.Money("USD")
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Int is an integer field of a parsed response. Besides integers, it accepts
+// floats, numeric strings and null, so that a value of an unexpected type does
+// not fail the decoding of the whole response. Other values, e.g. "1,043", and
+// numbers that are not whole or do not fit in an int are not valid and are
+// kept in Raw.
+type Int struct {
+	Value int
+	// Present reports whether the field was in the response, even if null.
+	Present bool
+	// Valid reports whether the field had a non-null integer value.
+	Valid bool
+	// Raw contains the JSON of a non-null value that is not a valid integer.
+	Raw string
+}
+
+// Float is a floating-point field of a parsed response. Besides numbers,
+// it accepts numeric strings and null. Other values are not valid and are
+// kept in Raw.
+type Float struct {
+	Value float64
+	// Present reports whether the field was in the response, even if null.
+	Present bool
+	// Valid reports whether the field had a non-null value.
+	Valid bool
+	// Raw contains the JSON of a non-null value that is not a valid number.
+	Raw string
+}
+
+// NewInt returns a valid Int with the given value.
+func NewInt(v int) Int {
+	return Int{Value: v, Present: true, Valid: true}
+}
+
+// NewFloat returns a valid Float with the given value.
+func NewFloat(v float64) Float {
+	return Float{Value: v, Present: true, Valid: true}
+}
+
+func (n *Int) UnmarshalJSON(data []byte) error {
+	v, valid, raw := decodeNumber(data)
+	if valid && (v != math.Trunc(v) || v < math.MinInt || v >= math.MaxInt) {
+		*n = Int{Present: true, Raw: string(bytes.TrimSpace(data))}
+		return nil
+	}
+
+	*n = Int{Value: int(v), Present: true, Valid: valid, Raw: raw}
+	return nil
+}
+
+func (n Int) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		if n.Raw != "" {
+			return []byte(n.Raw), nil
+		}
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.Itoa(n.Value)), nil
+}
+
+func (n Int) String() string {
+	if !n.Valid {
+		if n.Raw != "" {
+			return n.Raw
+		}
+		return "null"
+	}
+
+	return strconv.Itoa(n.Value)
+}
+
+func (n *Float) UnmarshalJSON(data []byte) error {
+	v, valid, raw := decodeNumber(data)
+	*n = Float{Value: v, Present: true, Valid: valid, Raw: raw}
+	return nil
+}
+
+func (n Float) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		if n.Raw != "" {
+			return []byte(n.Raw), nil
+		}
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+func (n Float) String() string {
+	if !n.Valid {
+		if n.Raw != "" {
+			return n.Raw
+		}
+		return "null"
+	}
+
+	return strconv.FormatFloat(n.Value, 'f', -1, 64)
+}
+
+// decodeNumber decodes a JSON number or numeric string. Null is not valid,
+// and the JSON of other values, which are not valid either, is returned.
+func decodeNumber(data []byte) (float64, bool, string) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return 0, false, ""
+	}
+
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, false, string(data)
+		}
+		s = strings.TrimSpace(s)
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false, string(data)
+	}
+
+	return v, true, ""
+}
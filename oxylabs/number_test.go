@@ -0,0 +1,64 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNumbers struct {
+	Count Int   `json:"count"`
+	Price Float `json:"price"`
+}
+
+func TestNumbers_Unmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		json  string
+		count Int
+		price Float
+	}{
+		{`{"count": 3, "price": 19.99}`, NewInt(3), NewFloat(19.99)},
+		{`{"count": 3.0, "price": 20}`, NewInt(3), NewFloat(20)},
+		{`{"count": "12", "price": " 19.99 "}`, NewInt(12), NewFloat(19.99)},
+		{`{"count": null, "price": null}`, Int{Present: true}, Float{Present: true}},
+		{`{}`, Int{}, Float{}},
+	} {
+		var v testNumbers
+		assert.NoError(t, json.Unmarshal([]byte(tc.json), &v), tc.json)
+		assert.Equal(t, tc.count, v.Count, tc.json)
+		assert.Equal(t, tc.price, v.Price, tc.json)
+	}
+}
+
+func TestInt_UnmarshalNotInteger(t *testing.T) {
+	for _, raw := range []string{`3.7`, `1e300`, `-1e300`, `"9223372036854775808"`} {
+		var v testNumbers
+		assert.NoError(t, json.Unmarshal([]byte(`{"count": `+raw+`}`), &v), raw)
+		assert.Equal(t, Int{Present: true, Raw: raw}, v.Count, raw)
+
+		b, err := json.Marshal(v.Count)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, string(b))
+	}
+}
+
+func TestNumbers_UnmarshalInvalid(t *testing.T) {
+	for _, raw := range []string{`"1,043"`, `"N/A"`, `""`, `true`, `"NaN"`, `[1]`} {
+		var v testNumbers
+		data := `{"count": ` + raw + `, "price": ` + raw + `}`
+		assert.NoError(t, json.Unmarshal([]byte(data), &v), raw)
+		assert.Equal(t, Int{Present: true, Raw: raw}, v.Count, raw)
+		assert.Equal(t, Float{Present: true, Raw: raw}, v.Price, raw)
+
+		b, err := json.Marshal(v)
+		assert.NoError(t, err)
+		assert.JSONEq(t, data, string(b))
+	}
+}
+
+func TestNumbers_Marshal(t *testing.T) {
+	b, err := json.Marshal(testNumbers{Count: NewInt(3)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count": 3, "price": null}`, string(b))
+}
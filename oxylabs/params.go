@@ -0,0 +1,14 @@
+package oxylabs
+
+// Params contains the parameters of a scraping request keyed by their API name,
+// e.g. "domain", "start_page" or "user_agent_type". Unset parameters use the
+// defaults of the source.
+//
+// A few keys are handled by the SDK instead of being sent as they are:
+//   - "context" holds the context options, either as []func(ContextOption)
+//     modifiers or as a map of context option names to values.
+//   - "parsing_instructions" holds the custom parsing instructions and enables parsing.
+//   - "lenient_context" forwards context options unknown to the source when true.
+//   - "poll_interval" is the time.Duration to wait between polling requests
+//     of the push-pull integration.
+type Params map[string]interface{}
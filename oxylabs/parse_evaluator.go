@@ -0,0 +1,605 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/htmlquery"
+	"github.com/antchfx/xpath"
+	"golang.org/x/net/html"
+)
+
+// CustomParserError describes a parsing function that failed while evaluating
+// the parse instructions, as reported in the `_errors` of the parsed content.
+type CustomParserError struct {
+	Fn    FnName `json:"_fn"`
+	FnIdx int    `json:"_fn_idx"`
+	Msg   string `json:"_msg"`
+	Path  string `json:"_path"`
+}
+
+// EvaluateParseInstructions runs the parse instructions against the HTML
+// content locally and returns the parsed content in the same structure as the
+// CustomContentParsed of the API results. Failing fields are set to null and
+// their errors are listed in `_errors`, unless `_on_error` of the function or
+// of the field is suppress, in which case the field is set to its `_default`.
+func EvaluateParseInstructions(
+	content string,
+	instructions *map[string]interface{},
+) (map[string]interface{}, error) {
+	if err := ValidateParseInstructions(instructions); err != nil {
+		return nil, err
+	}
+	typed, err := typedField(*instructions, "")
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := htmlquery.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing html: %w", err)
+	}
+
+	e := &evaluator{errors: []CustomParserError{}}
+	parsed := e.evalFields(typed, doc, "")
+	parsed["_errors"] = e.errors
+
+	// Round-trip the parsed content so that it has the types of
+	// the parsed content decoded from the API results.
+	b, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling parsed content: %w", err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshalling parsed content: %w", err)
+	}
+
+	return result, nil
+}
+
+type evaluator struct {
+	errors []CustomParserError
+}
+
+// evalFields evaluates the fields of the instructions against the node.
+func (e *evaluator) evalFields(
+	fields map[string]interface{},
+	node *html.Node,
+	path string,
+) map[string]interface{} {
+	// Evaluate the fields in order of their names, so that the
+	// order of the errors does not change between runs.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		if !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	parsed := make(map[string]interface{}, len(fields))
+	for _, name := range names {
+		f, _ := fields[name].(map[string]interface{})
+		parsed[name] = e.evalField(f, node, path+"/"+escapePointerToken(name))
+	}
+
+	return parsed
+}
+
+// evalField evaluates a single field against the node.
+func (e *evaluator) evalField(
+	field map[string]interface{},
+	node *html.Node,
+	path string,
+) interface{} {
+	fns, ok := field["_fns"].([]Fn)
+	if !ok {
+		return e.evalFields(field, node, path)
+	}
+
+	suppress := field["_on_error"] == OnErrorSuppress
+	var value interface{} = node
+	for i, fn := range fns {
+		var err error
+		value, err = evalFn(fn, value)
+		if err == nil {
+			continue
+		}
+
+		switch {
+		case fn.OnError == OnErrorSuppress:
+			return fn.Default
+		case suppress:
+			return field["_default"]
+		default:
+			e.errors = append(e.errors, CustomParserError{
+				Fn:    fn.Name,
+				FnIdx: i,
+				Msg:   err.Error(),
+				Path:  path,
+			})
+			return nil
+		}
+	}
+
+	items, ok := field["_items"].(map[string]interface{})
+	if !ok {
+		return output(value)
+	}
+
+	list := []interface{}{}
+	for _, v := range asList(value) {
+		n, ok := v.(*html.Node)
+		if !ok {
+			continue
+		}
+		list = append(list, e.evalFields(items, n, path+"/_items"))
+	}
+
+	return list
+}
+
+// output converts the nodes remaining in the value to their html.
+func output(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *html.Node:
+		return htmlquery.OutputHTML(v, true)
+	case []interface{}:
+		list := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			list = append(list, output(e))
+		}
+		return list
+	}
+
+	return value
+}
+
+func evalFn(fn Fn, value interface{}) (interface{}, error) {
+	switch fn.Name {
+	case Xpath, XpathOne:
+		return evalXpath(fn.Args.([]string), value, fn.Name == XpathOne)
+	case Css, CssOne:
+		return evalCss(fn.Args.([]string), value, fn.Name == CssOne)
+	case ElementText:
+		return mapValue(value, elementText)
+	case AmountFromString:
+		return mapValue(value, func(v interface{}) (interface{}, error) {
+			return amountFromString(v, fn.Args.(string))
+		})
+	case AmountRangeFromString:
+		return mapValue(value, func(v interface{}) (interface{}, error) {
+			return amountRangeFromString(v, fn.Args.(string))
+		})
+	case Join:
+		separator, _ := fn.Args.(string)
+		return join(value, separator)
+	case RegexFindAll:
+		return mapValue(value, func(v interface{}) (interface{}, error) {
+			return regexFindAll(v, fn.Args.(string))
+		})
+	case RegexSearch, RegexSubstring:
+		args := fn.Args.([]any)
+		group := 0
+		if len(args) > 1 {
+			group = args[1].(int)
+		}
+		return mapValue(value, func(v interface{}) (interface{}, error) {
+			return regexSearch(v, args[0].(string), group)
+		})
+	case Length:
+		return length(value)
+	case SelectNth:
+		return selectNth(value, fn.Args.(int))
+	case ConvertToFloat:
+		return mapValue(value, convertToFloat)
+	case ConvertToInt:
+		return mapValue(value, convertToInt)
+	case ConvertToStr:
+		return mapValue(value, convertToStr)
+	case Average:
+		return average(value, fn.Args)
+	case Max, Min, Product:
+		return aggregate(fn.Name, value)
+	}
+
+	return nil, fmt.Errorf("unsupported function %s", fn.Name)
+}
+
+// mapValue applies f to the value or to each element of the list.
+func mapValue(
+	value interface{},
+	f func(interface{}) (interface{}, error),
+) (interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return f(value)
+	}
+
+	result := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		r, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+func asList(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+
+	return []interface{}{value}
+}
+
+func evalXpath(exprs []string, value interface{}, one bool) (interface{}, error) {
+	node, ok := value.(*html.Node)
+	if !ok {
+		return nil, fmt.Errorf("xpath can only be applied to an element")
+	}
+
+	for _, e := range exprs {
+		expr, err := xpath.Compile(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid xpath expression %q: %w", e, err)
+		}
+
+		var matches []interface{}
+		switch r := expr.Evaluate(htmlquery.CreateXPathNavigator(node)).(type) {
+		case *xpath.NodeIterator:
+			for r.MoveNext() {
+				matches = append(matches, xpathNodeValue(r.Current().(*htmlquery.NodeNavigator)))
+			}
+		default:
+			matches = append(matches, r)
+		}
+
+		if len(matches) > 0 {
+			if one {
+				return matches[0], nil
+			}
+			return matches, nil
+		}
+	}
+
+	if one {
+		return nil, fmt.Errorf("xpath expressions did not match any data")
+	}
+	return []interface{}{}, nil
+}
+
+// xpathNodeValue returns the string of text and attribute nodes and the node otherwise.
+func xpathNodeValue(nav *htmlquery.NodeNavigator) interface{} {
+	switch nav.NodeType() {
+	case xpath.TextNode, xpath.AttributeNode, xpath.CommentNode:
+		return nav.Value()
+	}
+
+	return nav.Current()
+}
+
+func evalCss(selectors []string, value interface{}, one bool) (interface{}, error) {
+	node, ok := value.(*html.Node)
+	if !ok {
+		return nil, fmt.Errorf("css can only be applied to an element")
+	}
+
+	for _, s := range selectors {
+		selector, pseudo, attr := splitCssPseudoElement(s)
+		sel, err := cascadia.Compile(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid css selector %q: %w", s, err)
+		}
+
+		var matches []interface{}
+		for _, n := range sel.MatchAll(node) {
+			switch pseudo {
+			case "text":
+				for c := n.FirstChild; c != nil; c = c.NextSibling {
+					if c.Type == html.TextNode {
+						matches = append(matches, c.Data)
+					}
+				}
+			case "attr":
+				if htmlquery.ExistsAttr(n, attr) {
+					matches = append(matches, htmlquery.SelectAttr(n, attr))
+				}
+			default:
+				matches = append(matches, n)
+			}
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+		if one {
+			return matches[0], nil
+		}
+		return matches, nil
+	}
+
+	if one {
+		return nil, fmt.Errorf("css selectors did not match any data")
+	}
+	return []interface{}{}, nil
+}
+
+func elementText(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case *html.Node:
+		return strings.TrimSpace(htmlquery.InnerText(v)), nil
+	case string:
+		return strings.TrimSpace(v), nil
+	}
+
+	return nil, fmt.Errorf("element_text can only be applied to an element")
+}
+
+func toString(value interface{}, fn FnName) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s can only be applied to a string", fn)
+	}
+
+	return s, nil
+}
+
+func amountFromString(value interface{}, pattern string) (interface{}, error) {
+	amounts, err := amountRangeFromString(value, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	list := amounts.([]interface{})
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no amount found")
+	}
+
+	return list[0], nil
+}
+
+func amountRangeFromString(value interface{}, pattern string) (interface{}, error) {
+	s, err := toString(value, AmountRangeFromString)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+	}
+
+	amounts := []interface{}{}
+	for _, match := range re.FindAllString(s, -1) {
+		// Amounts are parsed like ParseMoney, e.g. "1.299,00" is 1299.
+		parsed, err := findAmounts(match)
+		if err != nil || len(parsed) == 0 {
+			return nil, fmt.Errorf("%q is not an amount", match)
+		}
+		amounts = append(amounts, parsed[0].Float64())
+	}
+
+	return amounts, nil
+}
+
+func join(value interface{}, separator string) (interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("join can only be applied to a list")
+	}
+
+	strs := make([]string, 0, len(list))
+	for _, v := range list {
+		s, err := toString(v, Join)
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, s)
+	}
+
+	return strings.Join(strs, separator), nil
+}
+
+func regexFindAll(value interface{}, pattern string) (interface{}, error) {
+	s, err := toString(value, RegexFindAll)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+	}
+
+	// Like Python re.findall, the matches are the group of patterns with
+	// one group and the list of the groups of patterns with several.
+	matches := []interface{}{}
+	for _, m := range re.FindAllStringSubmatch(s, -1) {
+		switch len(m) {
+		case 1:
+			matches = append(matches, m[0])
+		case 2:
+			matches = append(matches, m[1])
+		default:
+			groups := make([]interface{}, 0, len(m)-1)
+			for _, g := range m[1:] {
+				groups = append(groups, g)
+			}
+			matches = append(matches, groups)
+		}
+	}
+
+	return matches, nil
+}
+
+func regexSearch(value interface{}, pattern string, group int) (interface{}, error) {
+	s, err := toString(value, RegexSearch)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+	}
+
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("regex %q did not match any data", pattern)
+	}
+	if group < 0 || group >= len(match) {
+		return nil, fmt.Errorf("regex %q has no group %d", pattern, group)
+	}
+
+	return match[group], nil
+}
+
+func length(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v), nil
+	case string:
+		return len([]rune(v)), nil
+	}
+
+	return nil, fmt.Errorf("length can only be applied to a list or a string")
+}
+
+func selectNth(value interface{}, n int) (interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("select_nth can only be applied to a list")
+	}
+
+	i := n - 1
+	if n < 0 {
+		i = len(list) + n
+	}
+	if i < 0 || i >= len(list) {
+		return nil, fmt.Errorf("list has no element %d", n)
+	}
+
+	return list[i], nil
+}
+
+func convertToFloat(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q cannot be converted to float", v)
+		}
+		return f, nil
+	}
+
+	return nil, fmt.Errorf("%v cannot be converted to float", value)
+}
+
+func convertToInt(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case string:
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("%q cannot be converted to int", v)
+		}
+		return i, nil
+	}
+
+	return nil, fmt.Errorf("%v cannot be converted to int", value)
+}
+
+func convertToStr(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case *html.Node:
+		return htmlquery.InnerText(v), nil
+	}
+
+	return nil, fmt.Errorf("%v cannot be converted to string", value)
+}
+
+func toNumbers(value interface{}, fn FnName) ([]float64, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s can only be applied to a list", fn)
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("%s cannot be applied to an empty list", fn)
+	}
+
+	numbers := make([]float64, 0, len(list))
+	for _, v := range list {
+		switch n := v.(type) {
+		case int:
+			numbers = append(numbers, float64(n))
+		case float64:
+			numbers = append(numbers, n)
+		default:
+			return nil, fmt.Errorf("%s can only be applied to a list of numbers", fn)
+		}
+	}
+
+	return numbers, nil
+}
+
+func average(value interface{}, decimals any) (interface{}, error) {
+	numbers, err := toNumbers(value, Average)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := 0.0
+	for _, n := range numbers {
+		sum += n
+	}
+	avg := sum / float64(len(numbers))
+
+	if d, ok := decimals.(int); ok {
+		pow := math.Pow(10, float64(d))
+		avg = math.Round(avg*pow) / pow
+	}
+
+	return avg, nil
+}
+
+func aggregate(fn FnName, value interface{}) (interface{}, error) {
+	numbers, err := toNumbers(value, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := numbers[0]
+	for _, n := range numbers[1:] {
+		switch fn {
+		case Max:
+			result = math.Max(result, n)
+		case Min:
+			result = math.Min(result, n)
+		case Product:
+			result *= n
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHtml = `<html>
+<body>
+	<h1> Products </h1>
+	<ul>
+		<li class="product"><a href="/a">Shoe</a><span>$1,200.50</span><i>4</i><i>5</i></li>
+		<li class="product"><a href="/b">Shirt</a><span>$30</span><i>3</i></li>
+		<li class="product"><a href="/c">Hat</a><i>2</i></li>
+	</ul>
+	<p>Sizes: 38, 40, 42</p>
+</body>
+</html>`
+
+func TestEvaluateParseInstructions(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("title").XpathOne("//h1").ElementText()
+	pi.Field("count").Css("li.product").Length()
+	pi.Field("last_link").Xpath("//a/@href").SelectNth(-1)
+	pi.Field("sizes").XpathOne("//p/text()").RegexFindAll(`\d+`).ConvertToInt()
+	pi.Field("max_size").XpathOne("//p/text()").RegexFindAll(`\d+`).ConvertToFloat().Max()
+	pi.Field("first_size").XpathOne("//p/text()").RegexSearchGroup(`(\d+),`, 1).ConvertToInt()
+	pi.Field("names").Xpath("//a/text()").Join(", ")
+	items := pi.List("products", "//li")
+	items.Field("name").CssOne("a").ElementText()
+	items.Field("price").XpathOne(".//span/text()").AmountFromString(`[\d,.]+`).OnError(OnErrorSuppress).Default(0)
+	items.Field("rating").Xpath(".//i/text()").ConvertToInt().AverageRounded(1)
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+
+	parsed, err := EvaluateParseInstructions(testHtml, instructions)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"title":      "Products",
+		"count":      float64(3),
+		"last_link":  "/c",
+		"sizes":      []interface{}{float64(38), float64(40), float64(42)},
+		"max_size":   float64(42),
+		"first_size": float64(38),
+		"names":      "Shoe, Shirt, Hat",
+		"products": []interface{}{
+			map[string]interface{}{"name": "Shoe", "price": 1200.5, "rating": 4.5},
+			map[string]interface{}{"name": "Shirt", "price": float64(30), "rating": float64(3)},
+			map[string]interface{}{"name": "Hat", "price": float64(0), "rating": float64(2)},
+		},
+		"_errors": []interface{}{},
+	}, parsed)
+}
+
+func TestEvaluateParseInstructions_Errors(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("missing").XpathOne("//h2").ElementText()
+	pi.Nested("nested").Field("number").XpathOne("//h1/text()").ConvertToInt()
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+
+	parsed, err := EvaluateParseInstructions(testHtml, instructions)
+	assert.NoError(t, err)
+	assert.Nil(t, parsed["missing"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"_fn":     "xpath_one",
+			"_fn_idx": float64(0),
+			"_msg":    "xpath expressions did not match any data",
+			"_path":   "/missing",
+		},
+		map[string]interface{}{
+			"_fn":     "convert_to_int",
+			"_fn_idx": float64(1),
+			"_msg":    `" Products " cannot be converted to int`,
+			"_path":   "/nested/number",
+		},
+	}, parsed["_errors"])
+}
+
+func TestEvaluateParseInstructions_Invalid(t *testing.T) {
+	_, err := EvaluateParseInstructions(testHtml, &map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []Fn{{Name: SelectNth, Args: 0}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestEvaluateParseInstructions_RegexFindAllGroups(t *testing.T) {
+	const html = `<p>3 items, 12 items</p><b>1.299,00 €</b>`
+	pi := NewParseInstructions()
+	pi.Field("counts").XpathOne("//p/text()").RegexFindAll(`(\d+) items`)
+	pi.Field("pairs").XpathOne("//p/text()").RegexFindAll(`(\d+) (i)tems`)
+	pi.Field("price").XpathOne("//b/text()").AmountFromString(`[\d.,]+`)
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+
+	parsed, err := EvaluateParseInstructions(html, instructions)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"3", "12"}, parsed["counts"])
+	assert.Equal(t, []interface{}{
+		[]interface{}{"3", "i"},
+		[]interface{}{"12", "i"},
+	}, parsed["pairs"])
+	assert.Equal(t, float64(1299), parsed["price"])
+}
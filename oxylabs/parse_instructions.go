@@ -1,6 +1,13 @@
 package oxylabs
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
 
 type FnName string
 
@@ -30,28 +37,133 @@ const (
 	Product FnName = "product"
 )
 
+// OnError is the action taken when a parsing function fails.
+type OnError string
+
+const (
+	OnErrorFail     OnError = "fail"
+	OnErrorSuppress OnError = "suppress"
+)
+
+// Fn is a function in the `_fns` pipeline of a field. If OnError is
+// OnErrorSuppress, the failure of the function is ignored and Default
+// is used as its result.
 type Fn struct {
-	Name FnName `json:"_fn"`
-	Args any    `json:"_args,omitempty"`
+	Name    FnName  `json:"_fn"`
+	Args    any     `json:"_args,omitempty"`
+	OnError OnError `json:"_on_error,omitempty"`
+	Default any     `json:"_default,omitempty"`
 }
 
+// ValidateParseInstructions checks the parse instructions against the custom
+// parser grammar. Instructions decoded from JSON or YAML into
+// map[string]interface{} are accepted as well. Each field is a map that can hold the `_fns` pipeline, the
+// `_items` instructions applied to each element selected by `_fns`, the
+// `_on_error` and `_default` error handling keys, and nested fields.
+// A *ParseInstructionsError with the path of the invalid element is returned
+// when the instructions are invalid.
 func ValidateParseInstructions(instructions *map[string]interface{}) error {
 	if instructions == nil {
 		return fmt.Errorf("parse instructions cannot be nil")
 	}
 
-	for k, v := range *instructions {
+	return validateField(*instructions, "")
+}
+
+// LoadParseInstructions reads parse instructions stored as JSON and validates
+// them. The returned instructions have their `_fns` converted to []Fn with
+// arguments of the types the functions expect.
+func LoadParseInstructions(r io.Reader) (*map[string]interface{}, error) {
+	var instructions map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&instructions); err != nil {
+		return nil, fmt.Errorf("error decoding parse instructions: %w", err)
+	}
+	if err := ValidateParseInstructions(&instructions); err != nil {
+		return nil, err
+	}
+
+	typed, err := typedField(instructions, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &typed, nil
+}
+
+// typedField returns a copy of the validated field with `_fns` as []Fn
+// and `_on_error` as OnError.
+func typedField(field map[string]interface{}, path string) (map[string]interface{}, error) {
+	typed := make(map[string]interface{}, len(field))
+	for k, v := range field {
+		keyPath := path + "/" + escapePointerToken(k)
 		switch k {
 		case "_fns":
-			if err := validateFns(v); err != nil {
+			fns, err := pipelineFns(v, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			typed[k] = fns
+		case "_on_error":
+			typed[k] = OnError(fmt.Sprint(v))
+		case "_default":
+			typed[k] = v
+		default:
+			nested, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, newParseInstructionsError(keyPath, "invalid parse instructions format")
+			}
+			typedNested, err := typedField(nested, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			typed[k] = typedNested
+		}
+	}
+
+	return typed, nil
+}
+
+func validateField(field map[string]interface{}, path string) error {
+	keys := make([]string, 0, len(field))
+	for k := range field {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := field[k]
+		keyPath := path + "/" + escapePointerToken(k)
+		switch k {
+		case "_fns":
+			if err := validateFns(v, keyPath); err != nil {
 				return err
 			}
+		case "_items":
+			if _, ok := field["_fns"]; !ok {
+				return newParseInstructionsError(keyPath, "_items requires _fns to select the list elements")
+			}
+			items, ok := v.(map[string]interface{})
+			if !ok {
+				return newParseInstructionsError(keyPath, "_items must be a map of fields")
+			}
+			if err := validateField(items, keyPath); err != nil {
+				return err
+			}
+		case "_on_error":
+			if err := validateOnError(v, keyPath); err != nil {
+				return err
+			}
+		case "_default":
+			// Any value can be used as the default.
 		default:
+			if strings.HasPrefix(k, "_") {
+				return newParseInstructionsError(keyPath, fmt.Sprintf("unknown reserved key %s", k))
+			}
 			vv, ok := v.(map[string]interface{})
 			if !ok {
-				return fmt.Errorf("invalid parse instructions format")
+				return newParseInstructionsError(keyPath, "invalid parse instructions format")
 			}
-			if err := ValidateParseInstructions(&vv); err != nil {
+			if err := validateField(vv, keyPath); err != nil {
 				return err
 			}
 		}
@@ -60,43 +172,194 @@ func ValidateParseInstructions(instructions *map[string]interface{}) error {
 	return nil
 }
 
-func validateFns(fns interface{}) error {
-	if fns == nil {
-		return fmt.Errorf("_fns cannot be nil")
+func validateFns(fns interface{}, path string) error {
+	pipeline, err := pipelineFns(fns, path)
+	if err != nil {
+		return err
 	}
+	for i, fn := range pipeline {
+		if err := validatePipelineFn(fn, fmt.Sprintf("%s/%d", path, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pipelineFns returns the functions of the `_fns` pipeline. Functions decoded
+// from JSON have their arguments converted to the types the functions expect.
+func pipelineFns(fns interface{}, path string) ([]Fn, error) {
+	var pipeline []Fn
 	switch v := fns.(type) {
+	case nil:
+		return nil, newParseInstructionsError(path, "_fns cannot be nil")
 	case []Fn:
-		for _, f := range v {
-			if err := validateFn(f); err != nil {
-				return err
+		pipeline = append(pipeline, v...)
+	case []map[string]interface{}:
+		for i, f := range v {
+			fn, err := fnFromMap(f, fmt.Sprintf("%s/%d", path, i))
+			if err != nil {
+				return nil, err
 			}
+			pipeline = append(pipeline, fn)
 		}
-	case []map[string]interface{}:
-		for _, f := range v {
-			fnName, ok := f["_fn"]
+	case []interface{}:
+		for i, f := range v {
+			fnPath := fmt.Sprintf("%s/%d", path, i)
+			switch fv := f.(type) {
+			case Fn:
+				pipeline = append(pipeline, fv)
+			case map[string]interface{}:
+				fn, err := fnFromMap(fv, fnPath)
+				if err != nil {
+					return nil, err
+				}
+				pipeline = append(pipeline, fn)
+			default:
+				return nil, newParseInstructionsError(fnPath, "invalid _fn format")
+			}
+		}
+	default:
+		return nil, newParseInstructionsError(path, "invalid _fns format")
+	}
+
+	for i := range pipeline {
+		pipeline[i].Args = normalizeArgs(pipeline[i].Name, pipeline[i].Args)
+	}
+
+	return pipeline, nil
+}
+
+// normalizeArgs converts the arguments decoded from JSON, where lists are
+// []interface{} and numbers are float64, to the types expected by the function.
+// Arguments that cannot be converted are returned as they are.
+func normalizeArgs(name FnName, args any) any {
+	switch name {
+	case Xpath, XpathOne, Css, CssOne:
+		list, ok := args.([]interface{})
+		if !ok {
+			return args
+		}
+		strs := make([]string, 0, len(list))
+		for _, e := range list {
+			str, ok := e.(string)
 			if !ok {
-				return fmt.Errorf("_fn must be set")
+				return args
 			}
-			switch v := fnName.(type) {
-			case string:
-				if err := validateFn(Fn{Name: FnName(v), Args: f["_args"]}); err != nil {
-					return err
-				}
-			case FnName:
-				if err := validateFn(Fn{Name: v, Args: f["_args"]}); err != nil {
-					return err
+			strs = append(strs, str)
+		}
+		return strs
+	case SelectNth, Average:
+		if n, ok := toInt(args); ok {
+			return n
+		}
+	case RegexSearch, RegexSubstring:
+		switch list := args.(type) {
+		case []string:
+			normalized := make([]any, 0, len(list))
+			for _, e := range list {
+				normalized = append(normalized, e)
+			}
+			return normalized
+		case []interface{}:
+			normalized := append([]any{}, list...)
+			if len(normalized) > 1 {
+				if n, ok := toInt(normalized[1]); ok {
+					normalized[1] = n
 				}
-			default:
-				return fmt.Errorf("_fn must be string")
 			}
+			return normalized
+		}
+	}
+
+	return args
+}
+
+// toInt returns the int value of whole numbers decoded from JSON.
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case float64:
+		if n != math.Trunc(n) || math.IsInf(n, 0) {
+			return 0, false
 		}
+		return int(n), true
+	}
+
+	return 0, false
+}
+
+// fnFromMap returns the Fn described by the map.
+func fnFromMap(f map[string]interface{}, path string) (Fn, error) {
+	fn := Fn{Args: f["_args"], Default: f["_default"]}
+
+	for k := range f {
+		switch k {
+		case "_fn", "_args", "_on_error", "_default":
+		default:
+			return fn, newParseInstructionsError(path+"/"+escapePointerToken(k), fmt.Sprintf("unknown function key %s", k))
+		}
+	}
+
+	switch v := f["_fn"].(type) {
+	case nil:
+		return fn, newParseInstructionsError(path, "_fn must be set")
+	case string:
+		fn.Name = FnName(v)
+	case FnName:
+		fn.Name = v
 	default:
-		return fmt.Errorf("invalid _fns format")
+		return fn, newParseInstructionsError(path+"/_fn", "_fn must be string")
+	}
+
+	if onError, ok := f["_on_error"]; ok {
+		if err := validateOnError(onError, path+"/_on_error"); err != nil {
+			return fn, err
+		}
+		fn.OnError = OnError(fmt.Sprint(onError))
+	}
+
+	return fn, nil
+}
+
+func validatePipelineFn(fn Fn, path string) error {
+	if err := validateFn(fn); err != nil {
+		return &ParseInstructionsError{Path: path, Err: err}
+	}
+	if err := checkFnSyntax(fn); err != nil {
+		return &ParseInstructionsError{Path: path, Err: fmt.Errorf("_fn %s invalid: %w", fn.Name, err)}
+	}
+	if fn.OnError != "" {
+		return validateOnError(fn.OnError, path+"/_on_error")
 	}
 
 	return nil
 }
 
+func validateOnError(v interface{}, path string) error {
+	var onError OnError
+	switch o := v.(type) {
+	case string:
+		onError = OnError(o)
+	case OnError:
+		onError = o
+	default:
+		return newParseInstructionsError(path, "_on_error must be string")
+	}
+
+	if onError != OnErrorFail && onError != OnErrorSuppress {
+		return newParseInstructionsError(path, fmt.Sprintf("_on_error must be %s or %s", OnErrorFail, OnErrorSuppress))
+	}
+
+	return nil
+}
+
+// escapePointerToken escapes the key to be used in a JSON pointer.
+func escapePointerToken(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
 func validateFn(fn Fn) error {
 	var err error
 	switch fn.Name {
@@ -116,6 +379,8 @@ func validateFn(fn Fn) error {
 		err = validateNonZeroInt(fn.Args)
 	case Average:
 		err = validateOptionalInt(fn.Args)
+	default:
+		return fmt.Errorf("unknown function %s", fn.Name)
 	}
 
 	if err != nil {
@@ -205,7 +470,7 @@ func validateOptionalInt(args any) error {
 
 func validateListStringOptionalInt(args any) error {
 	a, ok := args.([]any)
-	if !ok {
+	if !ok || len(a) == 0 {
 		return fmt.Errorf("_args must be non empty list of arguments")
 	}
 
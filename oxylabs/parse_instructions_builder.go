@@ -0,0 +1,232 @@
+package oxylabs
+
+// ParseInstructions builds custom parsing instructions field by field.
+// The built instructions have the same structure ValidateParseInstructions
+// accepts and can be passed as the ParseInstructions option of any source:
+//
+//	pi := oxylabs.NewParseInstructions()
+//	pi.Field("title").XpathOne("//h1/text()")
+//	items := pi.List("items", "//li")
+//	items.Field("name").XpathOne(".//a/text()")
+//	items.Field("price").XpathOne(".//span/text()").AmountFromString(`\d+`)
+//
+//	instructions, err := pi.Build()
+type ParseInstructions struct {
+	fields map[string]interface{}
+}
+
+// NewParseInstructions returns empty parsing instructions.
+func NewParseInstructions() *ParseInstructions {
+	return &ParseInstructions{
+		fields: map[string]interface{}{},
+	}
+}
+
+// Field adds a field with the given name and returns the pipeline of functions
+// used to extract it. Adding a field with the same name replaces the previous one.
+func (p *ParseInstructions) Field(name string) *Pipeline {
+	pipeline := &Pipeline{fns: []Fn{}}
+	p.fields[name] = pipeline
+	return pipeline
+}
+
+// List adds a field with the given name that holds a list of the elements
+// selected by the xpath expression and returns the parsing instructions
+// applied to each of the elements.
+func (p *ParseInstructions) List(name string, xpath string) *ParseInstructions {
+	pipeline := p.Field(name).Xpath(xpath)
+	pipeline.items = NewParseInstructions()
+	return pipeline.items
+}
+
+// Nested adds a field with the given name that groups other fields and
+// returns the parsing instructions of the group.
+func (p *ParseInstructions) Nested(name string) *ParseInstructions {
+	nested := NewParseInstructions()
+	p.fields[name] = nested
+	return nested
+}
+
+// Build returns the parsing instructions after checking their validity.
+func (p *ParseInstructions) Build() (*map[string]interface{}, error) {
+	instructions := p.toMap()
+	if err := ValidateParseInstructions(&instructions); err != nil {
+		return nil, err
+	}
+
+	return &instructions, nil
+}
+
+func (p *ParseInstructions) toMap() map[string]interface{} {
+	m := make(map[string]interface{}, len(p.fields))
+	for name, field := range p.fields {
+		switch f := field.(type) {
+		case *Pipeline:
+			m[name] = f.toMap()
+		case *ParseInstructions:
+			m[name] = f.toMap()
+		}
+	}
+
+	return m
+}
+
+// Pipeline is the list of functions applied in order to extract a field.
+type Pipeline struct {
+	fns          []Fn
+	items        *ParseInstructions
+	onError      OnError
+	defaultValue any
+}
+
+func (p *Pipeline) toMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"_fns": p.fns,
+	}
+	if p.items != nil {
+		m["_items"] = p.items.toMap()
+	}
+	if p.onError != "" {
+		m["_on_error"] = p.onError
+	}
+	if p.defaultValue != nil {
+		m["_default"] = p.defaultValue
+	}
+
+	return m
+}
+
+func (p *Pipeline) add(name FnName, args any) *Pipeline {
+	p.fns = append(p.fns, Fn{Name: name, Args: args})
+	return p
+}
+
+// OnError sets the action taken when any function of the pipeline fails.
+func (p *Pipeline) OnError(action OnError) *Pipeline {
+	p.onError = action
+	return p
+}
+
+// Default sets the value of the field used when the pipeline fails and
+// its errors are suppressed.
+func (p *Pipeline) Default(value any) *Pipeline {
+	p.defaultValue = value
+	return p
+}
+
+// ElementText extracts the text content of the selected elements.
+func (p *Pipeline) ElementText() *Pipeline {
+	return p.add(ElementText, nil)
+}
+
+// Xpath selects all the elements matching any of the xpath expressions.
+func (p *Pipeline) Xpath(expr string, more ...string) *Pipeline {
+	return p.add(Xpath, append([]string{expr}, more...))
+}
+
+// XpathOne selects the first element matching any of the xpath expressions.
+func (p *Pipeline) XpathOne(expr string, more ...string) *Pipeline {
+	return p.add(XpathOne, append([]string{expr}, more...))
+}
+
+// Css selects all the elements matching any of the CSS selectors.
+func (p *Pipeline) Css(selector string, more ...string) *Pipeline {
+	return p.add(Css, append([]string{selector}, more...))
+}
+
+// CssOne selects the first element matching any of the CSS selectors.
+func (p *Pipeline) CssOne(selector string, more ...string) *Pipeline {
+	return p.add(CssOne, append([]string{selector}, more...))
+}
+
+// AmountFromString extracts an amount from the string using the regex pattern.
+func (p *Pipeline) AmountFromString(pattern string) *Pipeline {
+	return p.add(AmountFromString, pattern)
+}
+
+// AmountRangeFromString extracts an amount range from the string using the regex pattern.
+func (p *Pipeline) AmountRangeFromString(pattern string) *Pipeline {
+	return p.add(AmountRangeFromString, pattern)
+}
+
+// Join joins a list of strings with the separator.
+func (p *Pipeline) Join(separator string) *Pipeline {
+	return p.add(Join, separator)
+}
+
+// RegexFindAll finds all the matches of the regex pattern.
+func (p *Pipeline) RegexFindAll(pattern string) *Pipeline {
+	return p.add(RegexFindAll, pattern)
+}
+
+// RegexSearch finds the first match of the regex pattern.
+func (p *Pipeline) RegexSearch(pattern string) *Pipeline {
+	return p.add(RegexSearch, []any{pattern})
+}
+
+// RegexSearchGroup finds the given group of the first match of the regex pattern.
+func (p *Pipeline) RegexSearchGroup(pattern string, group int) *Pipeline {
+	return p.add(RegexSearch, []any{pattern, group})
+}
+
+// RegexSubstring extracts the substring matching the regex pattern.
+func (p *Pipeline) RegexSubstring(pattern string) *Pipeline {
+	return p.add(RegexSubstring, []any{pattern})
+}
+
+// RegexSubstringGroup extracts the given group of the substring matching the regex pattern.
+func (p *Pipeline) RegexSubstringGroup(pattern string, group int) *Pipeline {
+	return p.add(RegexSubstring, []any{pattern, group})
+}
+
+// Length returns the length of the list or string.
+func (p *Pipeline) Length() *Pipeline {
+	return p.add(Length, nil)
+}
+
+// SelectNth selects the nth element of the list. Negative indexes count
+// from the end of the list.
+func (p *Pipeline) SelectNth(n int) *Pipeline {
+	return p.add(SelectNth, n)
+}
+
+// ConvertToFloat converts the value to float.
+func (p *Pipeline) ConvertToFloat() *Pipeline {
+	return p.add(ConvertToFloat, nil)
+}
+
+// ConvertToInt converts the value to int.
+func (p *Pipeline) ConvertToInt() *Pipeline {
+	return p.add(ConvertToInt, nil)
+}
+
+// ConvertToStr converts the value to string.
+func (p *Pipeline) ConvertToStr() *Pipeline {
+	return p.add(ConvertToStr, nil)
+}
+
+// Average calculates the average of the list of numbers.
+func (p *Pipeline) Average() *Pipeline {
+	return p.add(Average, nil)
+}
+
+// AverageRounded calculates the average of the list of numbers rounded to
+// the given number of decimal places.
+func (p *Pipeline) AverageRounded(decimals int) *Pipeline {
+	return p.add(Average, decimals)
+}
+
+// Max returns the maximum of the list of numbers.
+func (p *Pipeline) Max() *Pipeline {
+	return p.add(Max, nil)
+}
+
+// Min returns the minimum of the list of numbers.
+func (p *Pipeline) Min() *Pipeline {
+	return p.add(Min, nil)
+}
+
+// Product returns the product of the list of numbers.
+func (p *Pipeline) Product() *Pipeline {
+	return p.add(Product, nil)
+}
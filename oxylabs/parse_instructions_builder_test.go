@@ -0,0 +1,113 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstructionsBuilder(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("title").XpathOne("//h1/text()")
+	pi.Field("price").
+		Css(".price").
+		ElementText().
+		RegexSearchGroup(`(\d+)`, 1).
+		ConvertToFloat()
+	items := pi.List("items", "//li")
+	items.Field("name").XpathOne(".//a/text()")
+	pi.Nested("meta").Field("count").Xpath("//li").Length()
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: XpathOne, Args: []string{"//h1/text()"}},
+			},
+		},
+		"price": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Css, Args: []string{".price"}},
+				{Name: ElementText},
+				{Name: RegexSearch, Args: []any{`(\d+)`, 1}},
+				{Name: ConvertToFloat},
+			},
+		},
+		"items": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//li"}},
+			},
+			"_items": map[string]interface{}{
+				"name": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: XpathOne, Args: []string{".//a/text()"}},
+					},
+				},
+			},
+		},
+		"meta": map[string]interface{}{
+			"count": map[string]interface{}{
+				"_fns": []Fn{
+					{Name: Xpath, Args: []string{"//li"}},
+					{Name: Length},
+				},
+			},
+		},
+	}, *instructions)
+}
+
+func TestParseInstructionsBuilder_AllFns(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("all").
+		Xpath("//a", "//b").
+		CssOne("a").
+		AmountFromString(`\d+`).
+		AmountRangeFromString(`\d+`).
+		Join(", ").
+		RegexFindAll(`\w+`).
+		RegexSearch(`\w+`).
+		RegexSubstring(`\w+`).
+		RegexSubstringGroup(`(\w+)`, 1).
+		SelectNth(-1).
+		ConvertToInt().
+		ConvertToStr().
+		Average().
+		AverageRounded(2).
+		Max().
+		Min().
+		Product()
+
+	_, err := pi.Build()
+	assert.NoError(t, err)
+}
+
+func TestParseInstructionsBuilder_Invalid(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("first").Xpath("")
+	_, err := pi.Build()
+	assert.EqualError(t, err, "/first/_fns/0: _fn xpath invalid: _args cannot have empty elements")
+
+	pi = NewParseInstructions()
+	pi.Field("first").SelectNth(0)
+	_, err = pi.Build()
+	assert.EqualError(t, err, "/first/_fns/0: _fn select_nth invalid: _args cannot be 0")
+}
+
+func TestParseInstructionsBuilder_OnError(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("price").XpathOne("//span/text()").ConvertToFloat().OnError(OnErrorSuppress).Default(0.0)
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"price": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: XpathOne, Args: []string{"//span/text()"}},
+				{Name: ConvertToFloat},
+			},
+			"_on_error": OnErrorSuppress,
+			"_default":  0.0,
+		},
+	}, *instructions)
+}
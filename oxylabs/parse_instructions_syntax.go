@@ -0,0 +1,253 @@
+package oxylabs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/xpath"
+)
+
+// ParseInstructionsWarning describes a possible problem in the parse
+// instructions that does not make them invalid. Path is the JSON pointer
+// of the function the warning is about.
+type ParseInstructionsWarning struct {
+	Path    string
+	Message string
+}
+
+func (w ParseInstructionsWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// CheckParseInstructions validates the parse instructions and returns warnings
+// about regexes that behave differently in the Python `re` dialect used by the
+// API and in Go, which is used by EvaluateParseInstructions.
+func CheckParseInstructions(
+	instructions *map[string]interface{},
+) ([]ParseInstructionsWarning, error) {
+	if err := ValidateParseInstructions(instructions); err != nil {
+		return nil, err
+	}
+	typed, err := typedField(*instructions, "")
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := []ParseInstructionsWarning{}
+	walkFns(typed, "", func(fn Fn, path string) {
+		pattern, ok := regexArg(fn)
+		if !ok {
+			return
+		}
+		for _, msg := range checkRegex(pattern).warnings {
+			warnings = append(warnings, ParseInstructionsWarning{Path: path, Message: msg})
+		}
+	})
+
+	return warnings, nil
+}
+
+// walkFns calls f with each function of the typed instructions and its path.
+func walkFns(field map[string]interface{}, path string, f func(fn Fn, path string)) {
+	keys := make([]string, 0, len(field))
+	for k := range field {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		keyPath := path + "/" + escapePointerToken(k)
+		switch v := field[k].(type) {
+		case []Fn:
+			for i, fn := range v {
+				f(fn, fmt.Sprintf("%s/%d", keyPath, i))
+			}
+		case map[string]interface{}:
+			walkFns(v, keyPath, f)
+		}
+	}
+}
+
+// checkFnSyntax checks the syntax of the xpath expressions, CSS selectors
+// and regexes in the arguments of the function.
+func checkFnSyntax(fn Fn) error {
+	switch fn.Name {
+	case Xpath, XpathOne:
+		for _, expr := range fn.Args.([]string) {
+			if _, err := xpath.Compile(expr); err != nil {
+				return fmt.Errorf("invalid xpath expression %q: %w", expr, err)
+			}
+		}
+	case Css, CssOne:
+		for _, selector := range fn.Args.([]string) {
+			sel, _, _ := splitCssPseudoElement(selector)
+			if _, err := cascadia.Compile(sel); err != nil {
+				return fmt.Errorf("invalid css selector %q: %w", selector, err)
+			}
+		}
+	default:
+		pattern, ok := regexArg(fn)
+		if !ok {
+			return nil
+		}
+		if err := checkRegex(pattern).err; err != nil {
+			return fmt.Errorf("invalid regex %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// regexArg returns the regex in the arguments of the function.
+func regexArg(fn Fn) (string, bool) {
+	switch fn.Name {
+	case AmountFromString, AmountRangeFromString, RegexFindAll:
+		pattern, ok := fn.Args.(string)
+		return pattern, ok
+	case RegexSearch, RegexSubstring:
+		args, ok := fn.Args.([]any)
+		if !ok || len(args) == 0 {
+			return "", false
+		}
+		pattern, ok := args[0].(string)
+		return pattern, ok
+	}
+
+	return "", false
+}
+
+var cssPseudoElement = regexp.MustCompile(`::(text|attr\(([^)]+)\))$`)
+
+// splitCssPseudoElement splits the `::text` and `::attr(name)` pseudo-elements
+// supported by the API from the CSS selector.
+func splitCssPseudoElement(selector string) (sel string, pseudo string, attr string) {
+	m := cssPseudoElement.FindStringSubmatchIndex(selector)
+	if m == nil {
+		return selector, "", ""
+	}
+
+	sel = strings.TrimSpace(selector[:m[0]])
+	if sel == "" {
+		sel = "*"
+	}
+	if m[4] < 0 {
+		return sel, "text", ""
+	}
+
+	return sel, "attr", strings.TrimSpace(selector[m[4]:m[5]])
+}
+
+type regexCheck struct {
+	err      error
+	warnings []string
+}
+
+// checkRegex checks the regex against the Python `re` dialect used by the API.
+// Constructs that Python does not support make the regex invalid. Constructs
+// that only Python supports, or that match differently in Python and Go,
+// result in warnings.
+func checkRegex(pattern string) regexCheck {
+	var check regexCheck
+	pythonOnly := false
+
+	runes := []rune(pattern)
+	inClass := false
+	unicodeClasses := false
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\\' && i+1 < len(runes):
+			e := runes[i+1]
+			i++
+			switch {
+			case strings.ContainsRune("pPQEzC", e):
+				check.err = fmt.Errorf(`\%c is not supported by Python re`, e)
+				return check
+			case strings.ContainsRune("dDwWsSbB", e):
+				unicodeClasses = true
+			case e == 'Z' && !inClass:
+				pythonOnly = true
+				check.warnings = append(check.warnings, `\Z is not supported by Go, use \z when evaluating locally`)
+			case e >= '1' && e <= '9' && !inClass:
+				pythonOnly = true
+				check.warnings = append(check.warnings, "backreferences are not supported by Go")
+			}
+		case inClass:
+			if c == '[' && peek(runes, i+1, 1) == ":" {
+				check.err = fmt.Errorf("POSIX character classes are not supported by Python re")
+				return check
+			}
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// A closing bracket at the start of the class is a literal.
+			if peek(runes, i+1, 1) == "^" {
+				i++
+			}
+			if peek(runes, i+1, 1) == "]" {
+				i++
+			}
+		case c == '(' && peek(runes, i+1, 1) == "?":
+			group := peek(runes, i+1, 3)
+			switch {
+			case strings.HasPrefix(group, "?="), strings.HasPrefix(group, "?!"),
+				strings.HasPrefix(group, "?<="), strings.HasPrefix(group, "?<!"):
+				pythonOnly = true
+				check.warnings = append(check.warnings, "lookarounds are not supported by Go")
+			case strings.HasPrefix(group, "?P="):
+				pythonOnly = true
+				check.warnings = append(check.warnings, "backreferences are not supported by Go")
+			case strings.HasPrefix(group, "?("):
+				pythonOnly = true
+				check.warnings = append(check.warnings, "conditional groups are not supported by Go")
+			case strings.HasPrefix(group, "?#"):
+				pythonOnly = true
+				check.warnings = append(check.warnings, "comments are not supported by Go")
+			case strings.HasPrefix(group, "?<"):
+				check.err = fmt.Errorf("named groups must be written as (?P<name>...) in Python re")
+				return check
+			case strings.HasPrefix(group, "?P<"), strings.HasPrefix(group, "?:"):
+			default:
+				// Inline flags, e.g. (?i) or (?s:...).
+				for j := i + 2; j < len(runes) && runes[j] != ')' && runes[j] != ':'; j++ {
+					switch runes[j] {
+					case 'U':
+						check.err = fmt.Errorf("the U flag is not supported by Python re")
+						return check
+					case 'a', 'L', 'u', 'x':
+						pythonOnly = true
+						check.warnings = append(check.warnings, fmt.Sprintf("the %c flag is not supported by Go", runes[j]))
+					}
+				}
+			}
+		}
+	}
+
+	if unicodeClasses {
+		check.warnings = append(check.warnings, `\d, \w, \s and \b match Unicode characters in Python and only ASCII ones in Go`)
+	}
+
+	if _, err := regexp.Compile(pattern); err != nil && !pythonOnly {
+		check.err = err
+	}
+
+	return check
+}
+
+// peek returns up to n runes starting at i.
+func peek(runes []rune, i int, n int) string {
+	if i >= len(runes) {
+		return ""
+	}
+	end := i + n
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	return string(runes[i:end])
+}
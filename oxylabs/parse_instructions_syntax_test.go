@@ -0,0 +1,118 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateParseInstructions_Syntax(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   Fn
+		err  string
+	}{
+		{
+			name: "invalid xpath",
+			fn:   Fn{Name: Xpath, Args: []string{"//div", "//div[@class="}},
+			err:  `/title/_fns/1: _fn xpath invalid: invalid xpath expression "//div[@class="`,
+		},
+		{
+			name: "invalid css",
+			fn:   Fn{Name: CssOne, Args: []string{"div > > p"}},
+			err:  `/title/_fns/1: _fn css_one invalid: invalid css selector "div > > p"`,
+		},
+		{
+			name: "invalid regex",
+			fn:   Fn{Name: RegexFindAll, Args: `(\d+`},
+			err:  `/title/_fns/1: _fn regex_find_all invalid: invalid regex "(\\d+"`,
+		},
+		{
+			name: "go named group",
+			fn:   Fn{Name: RegexSearch, Args: []any{`(?<price>\d+)`, 1}},
+			err:  `/title/_fns/1: _fn regex_search invalid: invalid regex "(?<price>\\d+)": named groups must be written as (?P<name>...) in Python re`,
+		},
+		{
+			name: "unicode class",
+			fn:   Fn{Name: AmountFromString, Args: `\p{N}+`},
+			err:  `/title/_fns/1: _fn amount_from_string invalid: invalid regex "\\p{N}+": \p is not supported by Python re`,
+		},
+		{
+			name: "posix class",
+			fn:   Fn{Name: RegexFindAll, Args: `[[:digit:]]+`},
+			err:  `/title/_fns/1: _fn regex_find_all invalid: invalid regex "[[:digit:]]+": POSIX character classes are not supported by Python re`,
+		},
+		{
+			name: "ungreedy flag",
+			fn:   Fn{Name: RegexFindAll, Args: `(?U)a+`},
+			err:  `/title/_fns/1: _fn regex_find_all invalid: invalid regex "(?U)a+": the U flag is not supported by Python re`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParseInstructions(&map[string]interface{}{
+				"title": map[string]interface{}{
+					"_fns": []Fn{{Name: ElementText}, tt.fn},
+				},
+			})
+			assert.ErrorContains(t, err, tt.err)
+
+			var piErr *ParseInstructionsError
+			assert.ErrorAs(t, err, &piErr)
+			assert.Equal(t, "/title/_fns/1", piErr.Path)
+		})
+	}
+}
+
+func TestValidateParseInstructions_ValidSyntax(t *testing.T) {
+	err := ValidateParseInstructions(&map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//div[@class='a']/text()", "count(//li)"}},
+				{Name: Css, Args: []string{"ul > li.product:nth-child(2n)", "a::attr(href)", "h1::text"}},
+				{Name: RegexFindAll, Args: `[]a-z]+`},
+				{Name: RegexSearch, Args: []any{`(?P<price>[\d.]+)(?=\s*EUR)`, 1}},
+				{Name: RegexSubstring, Args: []any{`(?i)(a)\1`}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCheckParseInstructions(t *testing.T) {
+	warnings, err := CheckParseInstructions(&map[string]interface{}{
+		"products": map[string]interface{}{
+			"_fns": []Fn{{Name: Xpath, Args: []string{"//li"}}},
+			"_items": map[string]interface{}{
+				"price": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: ElementText},
+						{Name: RegexSearch, Args: []any{`(?<=\$)\d+`}},
+					},
+				},
+			},
+		},
+		"title": map[string]interface{}{
+			"_fns": []Fn{{Name: RegexFindAll, Args: `[a-z]+`}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ParseInstructionsWarning{
+		{Path: "/products/_items/price/_fns/1", Message: "lookarounds are not supported by Go"},
+		{Path: "/products/_items/price/_fns/1", Message: `\d, \w, \s and \b match Unicode characters in Python and only ASCII ones in Go`},
+	}, warnings)
+}
+
+func TestEvaluateParseInstructions_CssPseudoElements(t *testing.T) {
+	pi := NewParseInstructions()
+	pi.Field("links").Css("li a::attr(href)")
+	pi.Field("title").CssOne("h1::text").ElementText()
+
+	instructions, err := pi.Build()
+	assert.NoError(t, err)
+
+	parsed, err := EvaluateParseInstructions(testHtml, instructions)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"/a", "/b", "/c"}, parsed["links"])
+	assert.Equal(t, "Products", parsed["title"])
+}
@@ -1,8 +1,10 @@
 package oxylabs
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
@@ -98,7 +100,7 @@ func TestValidateParseInstructions_StringArrayArgs(t *testing.T) {
 					"_fns": []map[string]interface{}{
 						{
 							"_fn":   fnName,
-							"_args": []string{"1st arg"},
+							"_args": []string{"div"},
 						},
 					},
 				}},
@@ -110,7 +112,7 @@ func TestValidateParseInstructions_StringArrayArgs(t *testing.T) {
 					"_fns": []map[string]interface{}{
 						{
 							"_fn":   fnName,
-							"_args": []string{"1st arg", "2nd string"},
+							"_args": []string{"div", "span"},
 						},
 					},
 				}},
@@ -696,3 +698,278 @@ func TestValidateParseInstructions_InvalidFnsFormat(t *testing.T) {
 	})
 	assert.Error(t, err)
 }
+
+func TestValidateParseInstructions_Items(t *testing.T) {
+	err := ValidateParseInstructions(&map[string]interface{}{
+		"products": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//li"}},
+			},
+			"_items": map[string]interface{}{
+				"price": map[string]interface{}{
+					"_fns": []map[string]interface{}{
+						{
+							"_fn":       "xpath_one",
+							"_args":     []string{".//span/text()"},
+							"_on_error": "suppress",
+							"_default":  "0",
+						},
+						{
+							"_fn": "convert_to_float",
+						},
+					},
+					"_on_error": "suppress",
+					"_default":  0.0,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateParseInstructions_ErrorPath(t *testing.T) {
+	err := ValidateParseInstructions(&map[string]interface{}{
+		"products": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//li"}},
+			},
+			"_items": map[string]interface{}{
+				"price": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: XpathOne, Args: []string{".//span/text()"}},
+						{Name: SelectNth, Args: 0},
+					},
+				},
+			},
+		},
+	})
+
+	var piErr *ParseInstructionsError
+	assert.ErrorAs(t, err, &piErr)
+	assert.Equal(t, "/products/_items/price/_fns/1", piErr.Path)
+	assert.EqualError(t, err, "/products/_items/price/_fns/1: _fn select_nth invalid: _args cannot be 0")
+}
+
+func TestValidateParseInstructions_EscapedErrorPath(t *testing.T) {
+	err := ValidateParseInstructions(&map[string]interface{}{
+		"a/b~c": "invalid item",
+	})
+
+	var piErr *ParseInstructionsError
+	assert.ErrorAs(t, err, &piErr)
+	assert.Equal(t, "/a~1b~0c", piErr.Path)
+}
+
+func TestValidateParseInstructions_InvalidReservedKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions map[string]interface{}
+		path         string
+	}{
+		{
+			name: "items without fns",
+			instructions: map[string]interface{}{
+				"products": map[string]interface{}{
+					"_items": map[string]interface{}{},
+				},
+			},
+			path: "/products/_items",
+		},
+		{
+			name: "items not a map",
+			instructions: map[string]interface{}{
+				"products": map[string]interface{}{
+					"_fns":   []Fn{{Name: Xpath, Args: []string{"//li"}}},
+					"_items": []string{"price"},
+				},
+			},
+			path: "/products/_items",
+		},
+		{
+			name: "invalid on error",
+			instructions: map[string]interface{}{
+				"title": map[string]interface{}{
+					"_fns":      []Fn{{Name: ElementText}},
+					"_on_error": "ignore",
+				},
+			},
+			path: "/title/_on_error",
+		},
+		{
+			name: "invalid fn on error",
+			instructions: map[string]interface{}{
+				"title": map[string]interface{}{
+					"_fns": []map[string]interface{}{
+						{"_fn": "element_text", "_on_error": 1},
+					},
+				},
+			},
+			path: "/title/_fns/0/_on_error",
+		},
+		{
+			name: "unknown reserved key",
+			instructions: map[string]interface{}{
+				"title": map[string]interface{}{
+					"_fn": "element_text",
+				},
+			},
+			path: "/title/_fn",
+		},
+		{
+			name: "unknown fn key",
+			instructions: map[string]interface{}{
+				"title": map[string]interface{}{
+					"_fns": []map[string]interface{}{
+						{"_fn": "element_text", "_arg": "x"},
+					},
+				},
+			},
+			path: "/title/_fns/0/_arg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParseInstructions(&tt.instructions)
+
+			var piErr *ParseInstructionsError
+			assert.ErrorAs(t, err, &piErr)
+			assert.Equal(t, tt.path, piErr.Path)
+		})
+	}
+}
+
+func TestValidateParseInstructions_JSONDecoded(t *testing.T) {
+	var instructions map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"products": {
+			"_fns": [{"_fn": "css", "_args": ["li.product"]}],
+			"_items": {
+				"name": {"_fns": [{"_fn": "xpath_one", "_args": [".//h2/text()"]}]},
+				"price": {
+					"_fns": [
+						{"_fn": "xpath", "_args": [".//span/text()"]},
+						{"_fn": "select_nth", "_args": -1},
+						{"_fn": "regex_search", "_args": ["(\\d+)", 1]},
+						{"_fn": "convert_to_float"}
+					]
+				},
+				"rating": {"_fns": [{"_fn": "xpath", "_args": [".//i/text()"]}, {"_fn": "average", "_args": 2}]}
+			}
+		}
+	}`), &instructions)
+	assert.NoError(t, err)
+	assert.NoError(t, ValidateParseInstructions(&instructions))
+}
+
+func TestValidateParseInstructions_JSONDecodedInvalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions string
+		path         string
+	}{
+		{
+			name:         "fractional select_nth index",
+			instructions: `{"title": {"_fns": [{"_fn": "select_nth", "_args": 1.5}]}}`,
+			path:         "/title/_fns/0",
+		},
+		{
+			name:         "non string xpath",
+			instructions: `{"title": {"_fns": [{"_fn": "xpath", "_args": [1]}]}}`,
+			path:         "/title/_fns/0",
+		},
+		{
+			name:         "fractional regex group",
+			instructions: `{"title": {"_fns": [{"_fn": "regex_search", "_args": ["(\\d+)", 0.5]}]}}`,
+			path:         "/title/_fns/0",
+		},
+		{
+			name:         "non map fn",
+			instructions: `{"title": {"_fns": ["xpath"]}}`,
+			path:         "/title/_fns/0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var instructions map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.instructions), &instructions))
+
+			err := ValidateParseInstructions(&instructions)
+
+			var piErr *ParseInstructionsError
+			assert.ErrorAs(t, err, &piErr)
+			assert.Equal(t, tt.path, piErr.Path)
+		})
+	}
+}
+
+func TestLoadParseInstructions(t *testing.T) {
+	instructions, err := LoadParseInstructions(strings.NewReader(`{
+		"products": {
+			"_fns": [{"_fn": "xpath", "_args": ["//li"]}],
+			"_items": {
+				"price": {
+					"_fns": [
+						{"_fn": "regex_search", "_args": ["(\\d+)", 1], "_on_error": "suppress"},
+						{"_fn": "select_nth", "_args": 2}
+					],
+					"_on_error": "suppress",
+					"_default": 0
+				}
+			}
+		}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"products": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//li"}},
+			},
+			"_items": map[string]interface{}{
+				"price": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: RegexSearch, Args: []any{`(\d+)`, 1}, OnError: OnErrorSuppress},
+						{Name: SelectNth, Args: 2},
+					},
+					"_on_error": OnErrorSuppress,
+					"_default":  float64(0),
+				},
+			},
+		},
+	}, *instructions)
+}
+
+func TestLoadParseInstructions_Invalid(t *testing.T) {
+	_, err := LoadParseInstructions(strings.NewReader(`{"title": `))
+	assert.Error(t, err)
+
+	_, err = LoadParseInstructions(strings.NewReader(`{"title": {"_fns": [{"_fn": "xpath"}]}}`))
+	var piErr *ParseInstructionsError
+	assert.ErrorAs(t, err, &piErr)
+	assert.Equal(t, "/title/_fns/0", piErr.Path)
+}
+
+func TestValidateParseInstructions_EmptyRegexArgs(t *testing.T) {
+	for _, fnName := range []FnName{RegexSearch, RegexSubstring} {
+		_, err := LoadParseInstructions(strings.NewReader(
+			fmt.Sprintf(`{"title": {"_fns": [{"_fn": %q, "_args": []}]}}`, fnName),
+		))
+		var piErr *ParseInstructionsError
+		assert.ErrorAs(t, err, &piErr)
+		assert.Equal(t, "/title/_fns/0", piErr.Path)
+
+		err = ValidateParseInstructions(&map[string]interface{}{
+			"title": map[string]interface{}{
+				"_fns": []Fn{{Name: fnName, Args: []string{}}},
+			},
+		})
+		assert.ErrorContains(t, err, "_args must be non empty list of arguments")
+	}
+}
+
+func TestValidateParseInstructions_UnknownFn(t *testing.T) {
+	_, err := LoadParseInstructions(strings.NewReader(`{"title": {"_fns": [{"_fn": "xpath_one", "_args": ["//h1"]}, {"_fn": "bogus"}]}}`))
+	var piErr *ParseInstructionsError
+	assert.ErrorAs(t, err, &piErr)
+	assert.Equal(t, "/title/_fns/1", piErr.Path)
+	assert.ErrorContains(t, err, "unknown function bogus")
+}
@@ -0,0 +1,89 @@
+package oxylabs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseStatus is the parse_status_code of the parsed content of a result.
+type ParseStatus int
+
+const (
+	// ParseStatusUnset is the status of results without a parse_status_code,
+	// e.g. results that are not parsed.
+	ParseStatusUnset           ParseStatus = 0
+	ParseStatusSuccess         ParseStatus = 12000
+	ParseStatusFailure         ParseStatus = 12002
+	ParseStatusNotSupported    ParseStatus = 12003
+	ParseStatusPartialSuccess  ParseStatus = 12004
+	ParseStatusWithWarnings    ParseStatus = 12005
+	ParseStatusUnexpectedError ParseStatus = 12006
+	ParseStatusUnknownPageType ParseStatus = 12007
+	ParseStatusNotFound        ParseStatus = 12009
+)
+
+var parseStatusMessages = map[ParseStatus]string{
+	ParseStatusUnset:           "parse status not set",
+	ParseStatusSuccess:         "parsed successfully",
+	ParseStatusFailure:         "failed to parse the page",
+	ParseStatusNotSupported:    "parsing is not supported for the page",
+	ParseStatusPartialSuccess:  "parsed with some fields failing",
+	ParseStatusWithWarnings:    "parsed with warnings",
+	ParseStatusUnexpectedError: "unexpected error while parsing",
+	ParseStatusUnknownPageType: "unknown page type",
+	ParseStatusNotFound:        "page not found",
+}
+
+// Message returns the meaning of the parse status code.
+func (s ParseStatus) Message() string {
+	if msg, ok := parseStatusMessages[s]; ok {
+		return msg
+	}
+
+	return "unknown parse status"
+}
+
+func (s ParseStatus) String() string {
+	return fmt.Sprintf("%d (%s)", int(s), s.Message())
+}
+
+// Parsed reports whether the page was parsed, possibly with some fields
+// failing or with warnings.
+func (s ParseStatus) Parsed() bool {
+	switch s {
+	case ParseStatusSuccess, ParseStatusPartialSuccess, ParseStatusWithWarnings:
+		return true
+	}
+
+	return false
+}
+
+// Failed reports whether the parse status is set and the page was not parsed.
+func (s ParseStatus) Failed() bool {
+	return s != ParseStatusUnset && !s.Parsed()
+}
+
+// ParseFailure describes a page of a job that the API failed to parse.
+type ParseFailure struct {
+	Page   int
+	Url    string
+	Status ParseStatus
+	// Errors contains the `_errors` of the parsed content.
+	Errors interface{}
+}
+
+// ParseError is returned in the strict parse mode when the API fails
+// to parse some of the pages of a job.
+type ParseError struct {
+	JobID    string
+	Failures []ParseFailure
+}
+
+func (e *ParseError) Error() string {
+	pages := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		pages = append(pages, fmt.Sprintf("page %d: %s", f.Page, f.Status))
+	}
+
+	return fmt.Sprintf("failed to parse %d pages of job %s: %s", len(e.Failures), e.JobID, strings.Join(pages, ", "))
+}
@@ -0,0 +1,310 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParseInstructionsFor returns the parse instructions described by the `oxy`
+// tags of the fields of the struct T. A tag lists the functions of the field's
+// pipeline separated by semicolons, each written as name or name=argument:
+//
+//	type Product struct {
+//		Title  string   `json:"title" oxy:"xpath_one=//h1/text();element_text"`
+//		Price  float64  `json:"price" oxy:"css_one=.price::text;amount_from_string=[\\d.]+"`
+//		Images []string `json:"images" oxy:"xpath=//img/@src"`
+//		Specs  []Spec   `json:"specs" oxy:"xpath=//table//tr"`
+//	}
+//
+// Fields are named after their json tag, or after the Go field otherwise.
+// The group of regex_search and regex_substring is set with a trailing comma,
+// e.g. regex_search=(\\d+) items,1. Semicolons in arguments are escaped as
+// \\; since backslashes are escaped in struct tags. A slice of structs with
+// a tag is a list whose elements are parsed with the fields of the struct,
+// and a struct without a tag is a group of nested fields. Fields without a
+// tag, or with the `oxy:"-"` tag, are not parsed.
+func ParseInstructionsFor[T any]() (*map[string]interface{}, error) {
+	t := indirectType(reflect.TypeOf((*T)(nil)).Elem())
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("parse instructions can only be generated for a struct, got %s", t)
+	}
+
+	pi := NewParseInstructions()
+	if err := structInstructions(t, pi); err != nil {
+		return nil, err
+	}
+
+	return pi.Build()
+}
+
+// DecodeParsed decodes the parsed content of custom parsing instructions into
+// the struct T, using the same field names as ParseInstructionsFor. Values are
+// converted to the types of the fields, e.g. the string "12" can be decoded
+// into an int field and a single value into a slice field.
+func DecodeParsed[T any](parsed map[string]interface{}) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if indirectType(rv.Type()).Kind() != reflect.Struct {
+		return v, fmt.Errorf("parsed content can only be decoded into a struct, got %s", rv.Type())
+	}
+
+	if err := decodeValue(parsed, rv, ""); err != nil {
+		return v, err
+	}
+
+	return v, nil
+}
+
+// structField is a field of a struct that is parsed.
+type structField struct {
+	reflect.StructField
+	name   string
+	tag    string
+	hasTag bool
+}
+
+// parsedFields returns the fields of the struct that are parsed.
+func parsedFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag, hasTag := f.Tag.Lookup("oxy")
+		if tag == "-" {
+			continue
+		}
+		if !hasTag && indirectType(f.Type).Kind() != reflect.Struct {
+			continue
+		}
+
+		name := f.Name
+		if jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ","); jsonName != "" && jsonName != "-" {
+			name = jsonName
+		}
+
+		fields = append(fields, structField{StructField: f, name: name, tag: tag, hasTag: hasTag})
+	}
+
+	return fields
+}
+
+func structInstructions(t reflect.Type, pi *ParseInstructions) error {
+	for _, f := range parsedFields(t) {
+		ft := indirectType(f.Type)
+		if !f.hasTag {
+			nested := pi.Nested(f.name)
+			if err := structInstructions(ft, nested); err != nil {
+				return err
+			}
+			if len(nested.fields) == 0 {
+				delete(pi.fields, f.name)
+			}
+			continue
+		}
+
+		fns, err := parseOxyTag(f.tag)
+		if err != nil {
+			return fmt.Errorf("invalid oxy tag of field %s: %w", f.Name, err)
+		}
+		pipeline := pi.Field(f.name)
+		pipeline.fns = fns
+
+		if ft.Kind() == reflect.Slice && indirectType(ft.Elem()).Kind() == reflect.Struct {
+			pipeline.items = NewParseInstructions()
+			if err := structInstructions(indirectType(ft.Elem()), pipeline.items); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseOxyTag returns the functions listed in the `oxy` tag.
+func parseOxyTag(tag string) ([]Fn, error) {
+	fns := []Fn{}
+	for _, part := range splitEscaped(tag, ';') {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, arg, hasArg := strings.Cut(part, "=")
+		fn := Fn{Name: FnName(strings.TrimSpace(name))}
+		switch fn.Name {
+		case ElementText, Length, ConvertToFloat, ConvertToInt, ConvertToStr, Max, Min, Product:
+			if hasArg {
+				return nil, fmt.Errorf("%s takes no argument", fn.Name)
+			}
+		case Xpath, XpathOne, Css, CssOne:
+			fn.Args = []string{arg}
+		case AmountFromString, AmountRangeFromString, RegexFindAll:
+			fn.Args = arg
+		case Join:
+			if hasArg {
+				fn.Args = arg
+			}
+		case RegexSearch, RegexSubstring:
+			fn.Args = []any{arg}
+			if i := strings.LastIndex(arg, ","); i >= 0 {
+				if group, err := strconv.Atoi(arg[i+1:]); err == nil {
+					fn.Args = []any{arg[:i], group}
+				}
+			}
+		case SelectNth, Average:
+			if !hasArg && fn.Name == Average {
+				break
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(arg))
+			if err != nil {
+				return nil, fmt.Errorf("%s takes an int argument", fn.Name)
+			}
+			fn.Args = n
+		default:
+			return nil, fmt.Errorf("unknown function %s", fn.Name)
+		}
+		fns = append(fns, fn)
+	}
+
+	return fns, nil
+}
+
+// splitEscaped splits s on sep, except where sep is escaped with a backslash.
+func splitEscaped(s string, sep byte) []string {
+	var parts []string
+	var part strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && s[i+1] == sep {
+			part.WriteByte(sep)
+			i++
+			continue
+		}
+		if s[i] == sep {
+			parts = append(parts, part.String())
+			part.Reset()
+			continue
+		}
+		part.WriteByte(s[i])
+	}
+
+	return append(parts, part.String())
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// decodeValue decodes the parsed value into rv, converting it to its type.
+func decodeValue(v interface{}, rv reflect.Value, path string) error {
+	if v == nil {
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return decodeValue(v, rv.Elem(), path)
+	case reflect.Interface:
+		if reflect.TypeOf(v).AssignableTo(rv.Type()) {
+			rv.Set(reflect.ValueOf(v))
+			return nil
+		}
+	case reflect.Struct:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, f := range parsedFields(rv.Type()) {
+			if err := decodeValue(m[f.name], rv.FieldByIndex(f.Index), path+"/"+escapePointerToken(f.name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		list, ok := v.([]interface{})
+		if !ok {
+			list = []interface{}{v}
+		}
+		slice := reflect.MakeSlice(rv.Type(), len(list), len(list))
+		for i, e := range list {
+			if err := decodeValue(e, slice.Index(i), fmt.Sprintf("%s/%d", path, i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+		return nil
+	case reflect.String:
+		switch s := v.(type) {
+		case string:
+			rv.SetString(s)
+			return nil
+		case float64:
+			rv.SetString(strconv.FormatFloat(s, 'f', -1, 64))
+			return nil
+		case bool:
+			rv.SetString(strconv.FormatBool(s))
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := parsedNumber(v); ok && n == math.Trunc(n) && !rv.OverflowInt(int64(n)) {
+			rv.SetInt(int64(n))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := parsedNumber(v); ok && n == math.Trunc(n) && n >= 0 && !rv.OverflowUint(uint64(n)) {
+			rv.SetUint(uint64(n))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, ok := parsedNumber(v); ok && !rv.OverflowFloat(n) {
+			rv.SetFloat(n)
+			return nil
+		}
+	case reflect.Bool:
+		switch b := v.(type) {
+		case bool:
+			rv.SetBool(b)
+			return nil
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(b)); err == nil {
+				rv.SetBool(parsed)
+				return nil
+			}
+		}
+	}
+
+	// Fall back to decoding the JSON of the value, e.g. for maps and time.Time.
+	b, err := json.Marshal(v)
+	if err == nil && json.Unmarshal(b, rv.Addr().Interface()) == nil {
+		return nil
+	}
+
+	return fmt.Errorf("cannot decode %v at %s into %s", v, path, rv.Type())
+}
+
+// parsedNumber returns the number of a parsed value, parsing strings.
+func parsedNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
@@ -0,0 +1,150 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testProduct struct {
+	Name    string  `json:"name" oxy:"css_one=a;element_text"`
+	Price   float64 `json:"price" oxy:"xpath_one=.//span/text();amount_from_string=[\\d,.]+"`
+	Ratings []int   `json:"ratings" oxy:"xpath=.//i/text();convert_to_int"`
+}
+
+type testPage struct {
+	Title    string        `json:"title" oxy:"xpath_one=//h1/text();element_text"`
+	Count    int           `oxy:"css=li.product;length"`
+	Size     string        `json:"size" oxy:"xpath_one=//p/text();regex_search=(\\d+)\\;?,1"`
+	Products []testProduct `json:"products" oxy:"xpath=//li"`
+	Meta     struct {
+		Links []string `json:"links" oxy:"xpath=//a/@href"`
+	} `json:"meta"`
+	Ignored string
+	Skipped string `oxy:"-"`
+}
+
+func TestParseInstructionsFor(t *testing.T) {
+	instructions, err := ParseInstructionsFor[testPage]()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: XpathOne, Args: []string{"//h1/text()"}},
+				{Name: ElementText},
+			},
+		},
+		"Count": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Css, Args: []string{"li.product"}},
+				{Name: Length},
+			},
+		},
+		"size": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: XpathOne, Args: []string{"//p/text()"}},
+				{Name: RegexSearch, Args: []any{`(\d+);?`, 1}},
+			},
+		},
+		"products": map[string]interface{}{
+			"_fns": []Fn{
+				{Name: Xpath, Args: []string{"//li"}},
+			},
+			"_items": map[string]interface{}{
+				"name": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: CssOne, Args: []string{"a"}},
+						{Name: ElementText},
+					},
+				},
+				"price": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: XpathOne, Args: []string{".//span/text()"}},
+						{Name: AmountFromString, Args: `[\d,.]+`},
+					},
+				},
+				"ratings": map[string]interface{}{
+					"_fns": []Fn{
+						{Name: Xpath, Args: []string{".//i/text()"}},
+						{Name: ConvertToInt},
+					},
+				},
+			},
+		},
+		"meta": map[string]interface{}{
+			"links": map[string]interface{}{
+				"_fns": []Fn{
+					{Name: Xpath, Args: []string{"//a/@href"}},
+				},
+			},
+		},
+	}, *instructions)
+}
+
+func TestParseInstructionsFor_InvalidTag(t *testing.T) {
+	_, err := ParseInstructionsFor[struct {
+		Title string `oxy:"xpath_one=//h1;element_text=x"`
+	}]()
+	assert.EqualError(t, err, "invalid oxy tag of field Title: element_text takes no argument")
+
+	_, err = ParseInstructionsFor[struct {
+		Title string `oxy:"xpath_first=//h1"`
+	}]()
+	assert.EqualError(t, err, "invalid oxy tag of field Title: unknown function xpath_first")
+
+	_, err = ParseInstructionsFor[struct {
+		Title string `oxy:"select_nth=0"`
+	}]()
+	assert.Error(t, err)
+
+	_, err = ParseInstructionsFor[string]()
+	assert.Error(t, err)
+}
+
+func TestDecodeParsed(t *testing.T) {
+	instructions, err := ParseInstructionsFor[testPage]()
+	assert.NoError(t, err)
+
+	parsed, err := EvaluateParseInstructions(testHtml, instructions)
+	assert.NoError(t, err)
+
+	page, err := DecodeParsed[testPage](parsed)
+	assert.NoError(t, err)
+	assert.Equal(t, "Products", page.Title)
+	assert.Equal(t, 3, page.Count)
+	assert.Equal(t, "38", page.Size)
+	assert.Equal(t, []testProduct{
+		{Name: "Shoe", Price: 1200.5, Ratings: []int{4, 5}},
+		{Name: "Shirt", Price: 30, Ratings: []int{3}},
+		{Name: "Hat", Ratings: []int{2}},
+	}, page.Products)
+	assert.Equal(t, []string{"/a", "/b", "/c"}, page.Meta.Links)
+}
+
+func TestDecodeParsed_Conversions(t *testing.T) {
+	type target struct {
+		Count   int      `json:"count" oxy:"length"`
+		Price   *float64 `json:"price" oxy:"convert_to_float"`
+		Label   string   `json:"label" oxy:"convert_to_str"`
+		Tags    []string `json:"tags" oxy:"join"`
+		InStock bool     `json:"in_stock" oxy:"convert_to_str"`
+	}
+
+	v, err := DecodeParsed[target](map[string]interface{}{
+		"count":    "12",
+		"price":    "9.99",
+		"label":    float64(7),
+		"tags":     "single",
+		"in_stock": "true",
+		"_errors":  []interface{}{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 12, v.Count)
+	assert.Equal(t, 9.99, *v.Price)
+	assert.Equal(t, "7", v.Label)
+	assert.Equal(t, []string{"single"}, v.Tags)
+	assert.True(t, v.InStock)
+
+	_, err = DecodeParsed[target](map[string]interface{}{"count": 1.5})
+	assert.EqualError(t, err, "cannot decode 1.5 at /count into int")
+}
@@ -0,0 +1,66 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// TimestampLayout is the layout of the timestamps returned by the API,
+// e.g. "2024-02-14 10:58:35", which have no zone and are in UTC.
+const TimestampLayout = "2006-01-02 15:04:05"
+
+// timestampLayouts are the layouts tried in order to parse a timestamp.
+var timestampLayouts = []string{
+	TimestampLayout,
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Timestamp is a timestamp of a response. Raw keeps the timestamp as returned
+// by the API, and the embedded time.Time is zero if it is null, empty or
+// cannot be parsed. Timestamps without a zone are in UTC.
+type Timestamp struct {
+	time.Time
+	Raw string
+}
+
+// ParseTimestamp parses a timestamp returned by the API.
+func ParseTimestamp(raw string) (Timestamp, error) {
+	var err error
+	for _, layout := range timestampLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, strings.TrimSpace(raw), time.UTC); err == nil {
+			return Timestamp{Time: t, Raw: raw}, nil
+		}
+	}
+
+	return Timestamp{Raw: raw}, err
+}
+
+// UnmarshalJSON unmarshals the timestamp, keeping the raw string if it
+// cannot be parsed instead of failing the decoding of the response.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var raw *string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*t = Timestamp{}
+		return nil
+	}
+
+	*t, _ = ParseTimestamp(*raw)
+	return nil
+}
+
+// MarshalJSON marshals the raw timestamp, or the time in the API layout
+// if there is none.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.Raw != "" || t.IsZero() {
+		return json.Marshal(t.Raw)
+	}
+
+	return json.Marshal(t.UTC().Format(TimestampLayout))
+}
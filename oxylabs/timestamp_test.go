@@ -0,0 +1,46 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp_Unmarshal(t *testing.T) {
+	var v struct {
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+		DeletedAt Timestamp `json:"deleted_at"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"created_at": "2024-02-14 10:58:35",
+		"updated_at": "2024-02-14T10:58:38.5+02:00",
+		"deleted_at": "yesterday"
+	}`), &v))
+
+	assert.Equal(t, time.Date(2024, 2, 14, 10, 58, 35, 0, time.UTC), v.CreatedAt.Time)
+	assert.Equal(t, "2024-02-14 10:58:35", v.CreatedAt.Raw)
+	assert.Equal(t, time.Date(2024, 2, 14, 8, 58, 38, 5e8, time.UTC), v.UpdatedAt.UTC())
+	assert.True(t, v.DeletedAt.IsZero())
+	assert.Equal(t, "yesterday", v.DeletedAt.Raw)
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"created_at": "2024-02-14 10:58:35",
+		"updated_at": "2024-02-14T10:58:38.5+02:00",
+		"deleted_at": "yesterday"
+	}`, string(b))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	ts, err := ParseTimestamp("2024-02-14 10:58:35.123456")
+	assert.NoError(t, err)
+	assert.Equal(t, 123456000, ts.Nanosecond())
+	assert.Equal(t, time.UTC, ts.Location())
+
+	_, err = ParseTimestamp("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package response
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Ranked is an item of the parsed content of a multi-page response,
+// ranked across the pages.
+type Ranked[T any] struct {
+	Item T
+	// Pos is the absolute position of the item across the pages, from 1.
+	Pos int
+	// Page is the page the item comes from.
+	Page int
+	// Url is the normalized URL of the item, see NormalizeUrl.
+	Url string
+}
+
+// Flatten returns the items of the parsed content of every page, in page
+// order, with their absolute position across the pages. Items whose
+// normalized URL already appeared on a previous position are dropped.
+// The items function returns the items of the parsed content of a page
+// and the url function the URL of an item.
+func Flatten[C, T any](r *Resp[C], items func(C) []T, url func(T) string) []Ranked[T] {
+	// Results are expected in page order, but pages are sorted to be safe.
+	results := make([]Results[C], len(r.Results))
+	copy(results, r.Results)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Page < results[j].Page
+	})
+
+	ranked := []Ranked[T]{}
+	seen := map[string]bool{}
+	for i, result := range results {
+		page := result.Page
+		if page == 0 {
+			page = i + 1
+		}
+
+		for _, item := range items(result.ContentParsed) {
+			normalized := NormalizeUrl(url(item))
+			if normalized != "" {
+				if seen[normalized] {
+					continue
+				}
+				seen[normalized] = true
+			}
+
+			ranked = append(ranked, Ranked[T]{
+				Item: item,
+				Pos:  len(ranked) + 1,
+				Page: page,
+				Url:  normalized,
+			})
+		}
+	}
+
+	return ranked
+}
+
+// trackingParams are query parameters that do not change the page of a URL.
+var trackingParams = map[string]bool{
+	"gclid":   true,
+	"fbclid":  true,
+	"msclkid": true,
+	"dclid":   true,
+	"yclid":   true,
+}
+
+// NormalizeUrl returns the canonical form of the URL, used to find duplicate
+// results: the scheme and the host are lower-cased, HTTP is upgraded to HTTPS,
+// the www. prefix, the default port, the fragment, the trailing slash and the
+// tracking query parameters, e.g. utm_source, are removed and the remaining
+// query parameters are sorted. URLs that cannot be parsed are only trimmed.
+func NormalizeUrl(rawUrl string) string {
+	rawUrl = strings.TrimSpace(rawUrl)
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return rawUrl
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme == "http" {
+		u.Scheme = "https"
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+	u.Host = host
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+
+	query := u.Query()
+	for name := range query {
+		if strings.HasPrefix(strings.ToLower(name), "utm_") || trackingParams[strings.ToLower(name)] {
+			query.Del(name)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,42 @@
+package response
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeUrl(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a":                          "https://example.com/a",
+		" HTTP://WWW.Example.com:80/a/#top ":             "https://example.com/a",
+		"https://example.com:8443/a?b=2&a=1":             "https://example.com:8443/a?a=1&b=2",
+		"https://example.com/a?utm_source=x&gclid=y&q=1": "https://example.com/a?q=1",
+		"https://example.com/":                           "https://example.com",
+		"/url?q=https://example.com":                     "/url?q=https://example.com",
+		"":                                               "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, NormalizeUrl(in), in)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	resp := &Resp[[]string]{Results: []Results[[]string]{
+		{Page: 2, ContentParsed: []string{"https://c.com", "https://a.com/?utm_medium=x", ""}},
+		{Page: 1, ContentParsed: []string{"https://a.com", "", "https://b.com"}},
+	}}
+
+	ranked := Flatten(resp,
+		func(c []string) []string { return c },
+		func(u string) string { return u },
+	)
+	assert.Equal(t, []Ranked[string]{
+		{Item: "https://a.com", Pos: 1, Page: 1, Url: "https://a.com"},
+		{Item: "", Pos: 2, Page: 1},
+		{Item: "https://b.com", Pos: 3, Page: 1, Url: "https://b.com"},
+		{Item: "https://c.com", Pos: 4, Page: 2, Url: "https://c.com"},
+		{Item: "", Pos: 5, Page: 2},
+	}, ranked)
+	assert.Equal(t, 2, resp.Results[0].Page, "results are not reordered")
+}
@@ -0,0 +1,379 @@
+// Package response contains the response envelope shared by the serp and
+// ecommerce packages. Resp and Results are parameterised by the type of the
+// parsed content, e.g. serp.Content, so that the results of either client
+// can be handled generically.
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Resp is the response of a scrape, whose parsed content is decoded into C.
+type Resp[C any] struct {
+	Parse             bool         `json:"parse"`
+	ParseInstructions bool         `json:"parse_instructions"`
+	Results           []Results[C] `json:"results"`
+	Job               oxylabs.Job  `json:"job"`
+	StatusCode        int          `json:"status_code"`
+	Status            string       `json:"status"`
+
+	// JobJSON contains the raw JSON of the job, including fields
+	// that are not mapped by Job.
+	JobJSON json.RawMessage `json:"-"`
+
+	// Diagnostics contains the differences between the response and its
+	// model when the strict decode mode of the client is enabled.
+	Diagnostics []oxylabs.Diagnostic `json:"-"`
+
+	// StrictDecode leaves the values of the parsed content that do not
+	// match their type unset instead of failing the decoding.
+	// It is set by the strict decode mode of the client.
+	StrictDecode bool `json:"-"`
+}
+
+// Results is the result of a page of a response. ContentParsed is set when
+// the content is parsed by the API, CustomContentParsed when it is parsed
+// by parse instructions and Content when it is not parsed.
+type Results[C any] struct {
+	CustomContentParsed map[string]interface{}
+	ContentParsed       C
+
+	// Content is decoded according to the requested content encoding and
+	// converted to UTF-8, unless it is binary, e.g. a PDF or an image,
+	// in which case it is left as returned by the API.
+	Content string
+
+	// RawContent contains the bytes of the decoded content. It is nil if
+	// the content was not encoded.
+	RawContent  []byte
+	ContentType string
+	Charset     string
+	Headers     map[string]interface{} `json:"headers"`
+
+	// DecodeErr is the error decoding the content, in which case Content
+	// is left as returned by the API.
+	DecodeErr error
+
+	CreatedAt  oxylabs.Timestamp `json:"created_at"`
+	UpdatedAt  oxylabs.Timestamp `json:"updated_at"`
+	Page       int               `json:"page"`
+	Url        string            `json:"url"`
+	JobID      string            `json:"job_id"`
+	StatusCode int               `json:"status_code"`
+	ParserType string            `json:"parser_type"`
+
+	// ContentJSON contains the raw JSON of the content, including fields
+	// that are not mapped by the content type.
+	ContentJSON json.RawMessage
+
+	// ParseStatus, Warnings and ParseErrors are set from the parsed content,
+	// whether it is parsed by the API or by parse instructions.
+	ParseStatus oxylabs.ParseStatus
+	Warnings    []string
+	ParseErrors interface{}
+}
+
+// UnmarshalJSON unmarshals the response according to the pre-set Parse and
+// ParseInstructions options, as the content of the results has a different
+// type depending on them.
+func (r *Resp[C]) UnmarshalJSON(data []byte) error {
+	// Unmarshal json data into RawResp map.
+	var rawResp map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawResp); err != nil {
+		return err
+	}
+
+	// Unmarshal the results array.
+	if resultsData, ok := rawResp["results"]; ok {
+		// Slice to store raw JSON messages for each result.
+		var resultsRawMessages []json.RawMessage
+		if err := json.Unmarshal(resultsData, &resultsRawMessages); err != nil {
+			return err
+		}
+
+		// Unmarshal each result into the Results slice.
+		for _, resultRawMessage := range resultsRawMessages {
+			// Keep the raw content of the result.
+			var raw struct {
+				Content json.RawMessage `json:"content"`
+			}
+			if err := json.Unmarshal(resultRawMessage, &raw); err != nil {
+				return err
+			}
+
+			if r.Parse && !r.ParseInstructions {
+				var result struct {
+					ContentParsed C                 `json:"content"`
+					CreatedAt     oxylabs.Timestamp `json:"created_at"`
+					UpdatedAt     oxylabs.Timestamp `json:"updated_at"`
+					Page          int               `json:"page"`
+					Url           string            `json:"url"`
+					JobID         string            `json:"job_id"`
+					StatusCode    int               `json:"status_code"`
+					ParserType    string            `json:"parser_type"`
+				}
+				// In the strict decode mode, values that do not match their type
+				// are left unset and reported by Diagnose instead.
+				if r.StrictDecode {
+					var err error
+					if resultRawMessage, err = internal.NullMismatches(resultRawMessage, reflect.TypeOf(result)); err != nil {
+						return err
+					}
+				}
+				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
+					return err
+				}
+				r.Results = append(r.Results, Results[C]{
+					ContentParsed: result.ContentParsed,
+					CreatedAt:     result.CreatedAt,
+					UpdatedAt:     result.UpdatedAt,
+					Page:          result.Page,
+					Url:           result.Url,
+					JobID:         result.JobID,
+					StatusCode:    result.StatusCode,
+					ParserType:    result.ParserType,
+				})
+			} else if r.Parse && r.ParseInstructions {
+				var result struct {
+					CustomContentParsed map[string]interface{} `json:"content"`
+					CreatedAt           oxylabs.Timestamp      `json:"created_at"`
+					UpdatedAt           oxylabs.Timestamp      `json:"updated_at"`
+					Page                int                    `json:"page"`
+					Url                 string                 `json:"url"`
+					JobID               string                 `json:"job_id"`
+					StatusCode          int                    `json:"status_code"`
+					ParserType          string                 `json:"parser_type"`
+				}
+				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
+					return err
+				}
+				r.Results = append(r.Results, Results[C]{
+					CustomContentParsed: result.CustomContentParsed,
+					CreatedAt:           result.CreatedAt,
+					UpdatedAt:           result.UpdatedAt,
+					Page:                result.Page,
+					Url:                 result.Url,
+					JobID:               result.JobID,
+					StatusCode:          result.StatusCode,
+					ParserType:          result.ParserType,
+				})
+			} else if !r.Parse {
+				var result struct {
+					Content    string                 `json:"content"`
+					Headers    map[string]interface{} `json:"headers"`
+					CreatedAt  oxylabs.Timestamp      `json:"created_at"`
+					UpdatedAt  oxylabs.Timestamp      `json:"updated_at"`
+					Page       int                    `json:"page"`
+					Url        string                 `json:"url"`
+					JobID      string                 `json:"job_id"`
+					StatusCode int                    `json:"status_code"`
+					ParserType string                 `json:"parser_type"`
+				}
+				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
+					return err
+				}
+				r.Results = append(r.Results, Results[C]{
+					Content:    result.Content,
+					Headers:    result.Headers,
+					CreatedAt:  result.CreatedAt,
+					UpdatedAt:  result.UpdatedAt,
+					Page:       result.Page,
+					Url:        result.Url,
+					JobID:      result.JobID,
+					StatusCode: result.StatusCode,
+					ParserType: result.ParserType,
+				})
+			}
+
+			// Set the raw content and the parse status of the result.
+			result := &r.Results[len(r.Results)-1]
+			result.ContentJSON = raw.Content
+			status := internal.ParseStatus(raw.Content)
+			result.ParseStatus = oxylabs.ParseStatus(status.ParseStatusCode)
+			result.Warnings = status.Warnings
+			result.ParseErrors = status.Errors
+		}
+	}
+
+	// Unmarshal the job object.
+	// The fields of the job that do not match their type are kept in its
+	// Extra fields and reported by Diagnose.
+	if jobData, ok := rawResp["job"]; ok {
+		r.JobJSON = jobData
+		var job oxylabs.Job
+		if err := json.Unmarshal(jobData, &job); err != nil {
+			return err
+		}
+		r.Job = job
+	}
+
+	// Decode the content according to the requested content encoding.
+	if !r.Parse {
+		for i := range r.Results {
+			r.Results[i].decodeContent(r.Job.ContentEncoding)
+		}
+	}
+
+	return nil
+}
+
+// decodeContent decodes the content of the result according to the content
+// encoding. An error is set in DecodeErr, so that the other results are kept.
+func (r *Results[C]) decodeContent(encoding string) {
+	if r.Content == "" {
+		return
+	}
+
+	decoded, err := internal.DecodeContent(r.Content, encoding, r.header("Content-Type"))
+	if err != nil {
+		r.DecodeErr = err
+		return
+	}
+
+	r.RawContent = decoded.Raw
+	r.ContentType = decoded.ContentType
+	r.Charset = decoded.Charset
+	if !decoded.Binary {
+		r.Content = decoded.Text
+	}
+}
+
+// header returns the value of the response header of the result.
+func (r *Results[C]) header(name string) string {
+	for k, v := range r.Headers {
+		if !strings.EqualFold(k, name) {
+			continue
+		}
+		if values, ok := v.([]interface{}); ok && len(values) > 0 {
+			return fmt.Sprint(values[0])
+		}
+		return fmt.Sprint(v)
+	}
+
+	return ""
+}
+
+// GetResp returns a Resp struct from the http.Response object.
+// It will use the parse and customParserFlag parameters
+// to determine how to parse the response.
+func GetResp[C any](
+	httpResp *http.Response,
+	parse bool,
+	customParserFlag bool,
+) (*Resp[C], error) {
+	res := &Resp[C]{Parse: parse, ParseInstructions: customParserFlag}
+	if err := Read(httpResp, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Read reads the http.Response object into the response, according to its
+// Parse, ParseInstructions and StrictDecode options.
+func Read[C any](httpResp *http.Response, res *Resp[C]) error {
+	// Read the resp body into a buffer.
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+
+	// If status code not 200, return error.
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
+	}
+
+	// Unmarshal the JSON object.
+	if err := res.UnmarshalJSON(respBody); err != nil {
+		return fmt.Errorf("failed to parse JSON object: %v", err)
+	}
+
+	// Set status code and status.
+	res.StatusCode = httpResp.StatusCode
+	res.Status = httpResp.Status
+
+	return nil
+}
+
+// Failed returns the results of the pages that the API failed to parse,
+// according to their parse status.
+func (r *Resp[C]) Failed() []Results[C] {
+	failed := []Results[C]{}
+	for _, result := range r.Results {
+		if result.ParseStatus.Failed() {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// ParseErr returns an *oxylabs.ParseError listing the pages that failed
+// to parse, or nil if every page was parsed. It is the error returned in
+// the strict parse mode.
+func (r *Resp[C]) ParseErr() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	err := &oxylabs.ParseError{JobID: r.Job.ID}
+	for _, result := range failed {
+		err.Failures = append(err.Failures, oxylabs.ParseFailure{
+			Page:   result.Page,
+			Url:    result.Url,
+			Status: result.ParseStatus,
+			Errors: result.ParseErrors,
+		})
+	}
+
+	return err
+}
+
+// DiagnoseWith compares the JSON of the parsed content of the results and of
+// the job with the structs they are decoded into, and returns the unknown
+// fields, the type mismatches and the missing fields. The content is compared
+// with the model of the source of the job, if any, or with C otherwise.
+// Missing fields are only reported for the models of the sources, since C
+// may contain the fields of several sources.
+func (r *Resp[C]) DiagnoseWith(models map[oxylabs.Source]reflect.Type) []oxylabs.Diagnostic {
+	model, typed := models[r.Job.Source]
+	if !typed {
+		model = reflect.TypeOf((*C)(nil)).Elem()
+	}
+
+	return r.diagnose(model, typed)
+}
+
+// DiagnoseTyped compares the JSON of the parsed content of the results and of
+// the job with C and oxylabs.Job, like DiagnoseWith, for a C that is the typed
+// content of the source of the response, e.g. serp.GoogleSearchContent. The
+// missing fields of C are reported too.
+func (r *Resp[C]) DiagnoseTyped() []oxylabs.Diagnostic {
+	return r.diagnose(reflect.TypeOf((*C)(nil)).Elem(), true)
+}
+
+// diagnose compares the parsed content of the results with the model and the
+// job with oxylabs.Job.
+func (r *Resp[C]) diagnose(model reflect.Type, reportMissing bool) []oxylabs.Diagnostic {
+	diagnostics := []oxylabs.Diagnostic{}
+	if r.Parse && !r.ParseInstructions {
+		for i, result := range r.Results {
+			path := fmt.Sprintf("/results/%d/content", i)
+			diagnostics = append(diagnostics, internal.CheckSchema(result.ContentJSON, model, path, reportMissing)...)
+		}
+	}
+	if r.JobJSON != nil {
+		diagnostics = append(diagnostics, internal.CheckSchema(r.JobJSON, reflect.TypeOf(oxylabs.Job{}), "/job", true)...)
+	}
+
+	return diagnostics
+}
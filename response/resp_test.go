@@ -0,0 +1,60 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testContent struct {
+	Title string      `json:"title"`
+	Price oxylabs.Int `json:"price"`
+}
+
+func testHttpResp(statusCode int, body string) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.WriteHeader(statusCode)
+	recorder.WriteString(body)
+
+	return recorder.Result()
+}
+
+func TestGetResp_Parsed(t *testing.T) {
+	resp, err := GetResp[testContent](testHttpResp(200, `{
+		"results": [
+			{"content": {"title": "adidas", "price": 10, "parse_status_code": 12000}, "page": 1},
+			{"content": {"parse_status_code": 12002}, "page": 2}
+		],
+		"job": {"id": "123", "status": "done", "priority": 0}
+	}`), true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "adidas", resp.Results[0].ContentParsed.Title)
+	assert.Equal(t, oxylabs.NewInt(10), resp.Results[0].ContentParsed.Price)
+	assert.Equal(t, oxylabs.JobStatusDone, resp.Job.Status)
+	assert.Contains(t, resp.Job.Extra, "priority")
+
+	assert.Len(t, resp.Failed(), 1)
+	var parseErr *oxylabs.ParseError
+	assert.ErrorAs(t, resp.ParseErr(), &parseErr)
+	assert.Equal(t, 2, parseErr.Failures[0].Page)
+}
+
+func TestGetResp_Unparsed(t *testing.T) {
+	resp, err := GetResp[testContent](testHttpResp(200, `{
+		"results": [{"content": "<html></html>", "page": 1}],
+		"job": {"id": "123", "content_encoding": "utf-8"}
+	}`), false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+	assert.Equal(t, oxylabs.ParseStatusUnset, resp.Results[0].ParseStatus)
+	assert.NoError(t, resp.ParseErr())
+}
+
+func TestGetResp_ErrorStatus(t *testing.T) {
+	_, err := GetResp[testContent](testHttpResp(401, `{"message": "Unauthorized"}`), true, false)
+	assert.EqualError(t, err, `error with status code 401 Unauthorized: {"message": "Unauthorized"}`)
+}
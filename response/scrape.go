@@ -0,0 +1,105 @@
+package response
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Scrape sends the payload with the client and decodes the response into
+// a Resp[C]. If async is set, the job of the payload is submitted and polled
+// until it is done instead. In the strict decode mode of the client, the
+// diagnostics of the response are set with the diagnose function, and in
+// the strict parse mode, the pages that failed to parse fail the scrape.
+func Scrape[C any](
+	ctx context.Context,
+	c *internal.Client,
+	payload *internal.Payload,
+	async bool,
+	diagnose func(*Resp[C]) []oxylabs.Diagnostic,
+) (*Resp[C], error) {
+	var httpResp *http.Response
+	var err error
+	if async {
+		httpResp, err = pollJob(ctx, c, payload)
+	} else {
+		httpResp, err = c.Req(ctx, payload.JSON, "POST")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the http Response and get the response.
+	res := &Resp[C]{
+		Parse:             payload.Parse,
+		ParseInstructions: payload.CustomParser,
+		StrictDecode:      c.StrictDecode,
+	}
+	if err := Read(httpResp, res); err != nil {
+		return nil, err
+	}
+	res.Diagnostics = c.Diagnose(func() []oxylabs.Diagnostic {
+		return diagnose(res)
+	})
+
+	// Fail on the pages that did not parse in the strict parse mode.
+	if c.StrictParse {
+		if err := res.ParseErr(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ScrapeParsed scrapes the payload with parsing enabled, like Scrape, and
+// decodes the parsed content of the results into the typed content T of
+// its source, e.g. serp.GoogleSearchContent.
+func ScrapeParsed[T any](
+	ctx context.Context,
+	c *internal.Client,
+	payload *internal.Payload,
+	async bool,
+) (*Resp[T], error) {
+	if payload.CustomParser {
+		return nil, fmt.Errorf("parse instructions cannot be used with typed responses, use Scrape instead")
+	}
+
+	return Scrape(ctx, c, payload, async, (*Resp[T]).DiagnoseTyped)
+}
+
+// pollJob submits the job of the payload and returns the http.Response
+// of its results once it is done.
+func pollJob(
+	ctx context.Context,
+	c *internal.Client,
+	payload *internal.Payload,
+) (*http.Response, error) {
+	errChan := make(chan error)
+	httpRespChan := make(chan *http.Response)
+
+	// Get job ID.
+	jobID, err := c.GetJobID(payload.JSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// Poll job status.
+	go c.PollJobStatus(
+		ctx,
+		jobID,
+		payload.PollInterval,
+		httpRespChan,
+		errChan,
+	)
+
+	// Handle error.
+	if err := <-errChan; err != nil {
+		return nil, err
+	}
+
+	return <-httpRespChan, nil
+}
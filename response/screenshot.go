@@ -0,0 +1,35 @@
+package response
+
+import (
+	"image"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// Screenshot returns the PNG of a result of a request
+// with the render parameter set to oxylabs.PNG.
+func (r *Results[C]) Screenshot() ([]byte, error) {
+	return internal.DecodeScreenshot(r.screenshotContent())
+}
+
+// ScreenshotImage returns the image of the PNG of a result of a request
+// with the render parameter set to oxylabs.PNG.
+func (r *Results[C]) ScreenshotImage() (image.Image, error) {
+	return internal.DecodeScreenshotImage(r.screenshotContent())
+}
+
+// SaveScreenshot writes the PNG of a result of a request
+// with the render parameter set to oxylabs.PNG to the file at path.
+func (r *Results[C]) SaveScreenshot(path string) error {
+	return internal.SaveScreenshot(r.screenshotContent(), path)
+}
+
+// screenshotContent returns the decoded bytes of the content if the content
+// was decoded according to its content encoding, and the content otherwise.
+func (r *Results[C]) screenshotContent() string {
+	if r.RawContent != nil {
+		return string(r.RawContent)
+	}
+
+	return r.Content
+}
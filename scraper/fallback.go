@@ -0,0 +1,54 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+)
+
+// FallbackScraper tries its scrapers in order and falls back to the next
+// one if ShouldFallback reports true for the error of the previous one.
+// By default it only falls back on timeouts.
+type FallbackScraper struct {
+	Scrapers       []Scraper
+	ShouldFallback func(err error) bool
+}
+
+// NewFallback returns a Scraper trying the given scrapers in order,
+// e.g. realtime first and push-pull if the realtime request times out.
+func NewFallback(scrapers ...Scraper) *FallbackScraper {
+	return &FallbackScraper{
+		Scrapers:       scrapers,
+		ShouldFallback: IsTimeout,
+	}
+}
+
+// Scrape scrapes the request with the first scraper which succeeds.
+func (s *FallbackScraper) Scrape(
+	ctx context.Context,
+	req Request,
+) (*Result, error) {
+	if len(s.Scrapers) == 0 {
+		return nil, fmt.Errorf("no scrapers to scrape with")
+	}
+
+	shouldFallback := s.ShouldFallback
+	if shouldFallback == nil {
+		shouldFallback = IsTimeout
+	}
+
+	var err error
+	for _, scraper := range s.Scrapers {
+		var res *Result
+		res, err = scraper.Scrape(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+
+		// Stop if the error is not recoverable or the caller's context is done.
+		if !shouldFallback(err) || ctx.Err() != nil {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,130 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/proxy"
+)
+
+// ProxyScraper scrapes requests via the Proxy Endpoint integration method.
+// Only URL requests can be scraped via the proxy endpoint and the parameters
+// are limited to user_agent_type, render, geo_location and parse.
+type ProxyScraper struct {
+	Client *http.Client
+}
+
+// NewProxy returns a Scraper using the Proxy Endpoint integration method.
+func NewProxy(
+	username string,
+	password string,
+) (*ProxyScraper, error) {
+	client, err := proxy.Init(username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProxyScraper{Client: client}, nil
+}
+
+// Scrape scrapes the request via the Proxy Endpoint integration method.
+func (s *ProxyScraper) Scrape(
+	ctx context.Context,
+	req Request,
+) (*Result, error) {
+	// Check validity of the URL.
+	host := ""
+	if req.Source != "" {
+		spec, err := internal.Sources().Lookup(req.Source)
+		if err != nil {
+			return nil, err
+		}
+		if spec.Input != internal.InputUrl {
+			return nil, fmt.Errorf("%s source cannot be scraped via the proxy endpoint", req.Source)
+		}
+		host = spec.Host
+	}
+	if err := internal.ValidateUrl(req.Input, host); err != nil {
+		return nil, err
+	}
+
+	// Prepare req.
+	httpReq, err := internal.NewRequestWithContext(ctx, "GET", req.Input, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := addProxyHeaders(httpReq, req.Params); err != nil {
+		return nil, err
+	}
+
+	// Get resp.
+	httpResp, err := s.Client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %w", err)
+	}
+
+	return &Result{
+		Method:     MethodProxyEndpoint,
+		StatusCode: httpResp.StatusCode,
+		Status:     httpResp.Status,
+		Body:       body,
+	}, nil
+}
+
+// addProxyHeaders adds the headers of the params accepted by the proxy endpoint to the req.
+func addProxyHeaders(req *http.Request, params oxylabs.Params) error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := params[name]
+		if internal.IsZero(value) {
+			continue
+		}
+
+		switch name {
+		case "user_agent_type":
+			userAgent := oxylabs.UserAgent(fmt.Sprint(value))
+			if !oxylabs.IsUserAgentValid(userAgent) {
+				return fmt.Errorf("invalid user agent parameter: %v", userAgent)
+			}
+			proxy.AddUserAgentHeader(req, userAgent)
+		case "render":
+			render := oxylabs.Render(fmt.Sprint(value))
+			if !oxylabs.IsRenderValid(render) {
+				return fmt.Errorf("invalid render parameter: %v", render)
+			}
+			proxy.AddRenderHeader(req, render)
+		case "geo_location":
+			req.Header.Add("x-oxylabs-geo-location", fmt.Sprint(value))
+		case "parse":
+			if value == true {
+				req.Header.Add("x-oxylabs-parse", "1")
+			}
+		case "poll_interval":
+			// Only used by the push-pull integration method.
+		default:
+			return &oxylabs.ValidationError{
+				Key:    name,
+				Value:  value,
+				Reason: "not supported by the proxy endpoint",
+			}
+		}
+	}
+
+	return nil
+}
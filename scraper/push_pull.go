@@ -0,0 +1,92 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// PushPullScraper scrapes requests via the Push-Pull integration method,
+// polling for the results until they are ready.
+type PushPullScraper struct {
+	C *internal.Client
+}
+
+// NewPushPull returns a Scraper using the Push-Pull integration method.
+func NewPushPull(
+	username string,
+	password string,
+) *PushPullScraper {
+	return &PushPullScraper{
+		C: &internal.Client{
+			BaseUrl: internal.AsyncBaseUrl,
+			ApiCredentials: &internal.ApiCredentials{
+				Username: username,
+				Password: password,
+			},
+			HttpClient: &http.Client{},
+		},
+	}
+}
+
+// Scrape scrapes the request via the Push-Pull integration method.
+func (s *PushPullScraper) Scrape(
+	ctx context.Context,
+	req Request,
+) (*Result, error) {
+	errChan := make(chan error)
+	httpRespChan := make(chan *http.Response)
+
+	// Check validity of parameters and prepare payload.
+	payload, err := internal.Sources().BuildPayload(req.Source, req.Input, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get job ID.
+	jobID, err := s.C.GetJobID(payload.JSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// Poll job status.
+	go s.C.PollJobStatus(
+		ctx,
+		jobID,
+		payload.PollInterval,
+		httpRespChan,
+		errChan,
+	)
+
+	// Handle error.
+	err = <-errChan
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the resp body.
+	httpResp := <-httpRespChan
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, body)
+	}
+
+	return &Result{
+		Method:       MethodPushPull,
+		StatusCode:   httpResp.StatusCode,
+		Status:       httpResp.Status,
+		Body:         body,
+		JobID:        jobID,
+		parse:        payload.Parse,
+		customParser: payload.CustomParser,
+	}, nil
+}
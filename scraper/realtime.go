@@ -0,0 +1,85 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// RealtimeScraper scrapes requests via the Realtime integration method.
+// Timeout limits how long to wait for each response. If it is not set,
+// the default timeout is used when the context has no deadline.
+type RealtimeScraper struct {
+	C       *internal.Client
+	Timeout time.Duration
+}
+
+// NewRealtime returns a Scraper using the Realtime integration method.
+func NewRealtime(
+	username string,
+	password string,
+) *RealtimeScraper {
+	return &RealtimeScraper{
+		C: &internal.Client{
+			BaseUrl: internal.SyncBaseUrl,
+			ApiCredentials: &internal.ApiCredentials{
+				Username: username,
+				Password: password,
+			},
+			HttpClient: &http.Client{},
+		},
+	}
+}
+
+// Scrape scrapes the request via the Realtime integration method.
+func (s *RealtimeScraper) Scrape(
+	ctx context.Context,
+	req Request,
+) (*Result, error) {
+	// Check validity of parameters and prepare payload.
+	payload, err := internal.Sources().BuildPayload(req.Source, req.Input, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limit the time to wait for the response.
+	if s.Timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	} else if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, internal.DefaultTimeout)
+		defer cancel()
+	}
+
+	// Req.
+	httpResp, err := s.C.Req(ctx, payload.JSON, "POST")
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	// Read the resp body.
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, body)
+	}
+
+	return &Result{
+		Method:       MethodRealtime,
+		StatusCode:   httpResp.StatusCode,
+		Status:       httpResp.Status,
+		Body:         body,
+		parse:        payload.Parse,
+		customParser: payload.CustomParser,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package scraper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/ecommerce"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// Scraper scrapes requests via one of the Oxylabs integration methods.
+type Scraper interface {
+	Scrape(ctx context.Context, req Request) (*Result, error)
+}
+
+// Method is an integration method of Oxylabs Scraper APIs.
+type Method string
+
+const (
+	MethodRealtime      Method = "realtime"
+	MethodPushPull      Method = "push_pull"
+	MethodProxyEndpoint Method = "proxy_endpoint"
+)
+
+// Request is a scraping request independent of the integration method.
+// Input is the query or the URL to scrape, depending on the source, and
+// Params holds the parameters keyed by their API name.
+type Request struct {
+	Source oxylabs.Source
+	Input  string
+	Params oxylabs.Params
+}
+
+// Result is the result of a scraping request.
+// Body is the JSON response of the realtime and push-pull integration
+// methods and the scraped content for the proxy endpoint.
+type Result struct {
+	Method     Method
+	StatusCode int
+	Status     string
+	Body       []byte
+	JobID      string
+
+	parse        bool
+	customParser bool
+}
+
+// SerpResp decodes the result as a response of Oxylabs SERP API.
+func (r *Result) SerpResp() (*serp.Resp, error) {
+	if r.Method == MethodProxyEndpoint {
+		return nil, fmt.Errorf("proxy endpoint results contain the scraped content only")
+	}
+
+	return serp.GetResp(r.httpResp(), r.parse, r.customParser)
+}
+
+// EcommerceResp decodes the result as a response of Oxylabs E-Commerce API.
+func (r *Result) EcommerceResp() (*ecommerce.Resp, error) {
+	if r.Method == MethodProxyEndpoint {
+		return nil, fmt.Errorf("proxy endpoint results contain the scraped content only")
+	}
+
+	return ecommerce.GetResp(r.httpResp(), r.parse, r.customParser)
+}
+
+// httpResp returns the result as an http Response.
+func (r *Result) httpResp() *http.Response {
+	return &http.Response{
+		StatusCode: r.StatusCode,
+		Status:     r.Status,
+		Body:       io.NopCloser(bytes.NewReader(r.Body)),
+	}
+}
+
+// New returns a Scraper using the given integration methods. If more than one
+// method is given, the methods are tried in order and the next one is used
+// if the previous one times out.
+func New(
+	username string,
+	password string,
+	methods ...Method,
+) (Scraper, error) {
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("at least one integration method is required")
+	}
+
+	scrapers := make([]Scraper, 0, len(methods))
+	for _, method := range methods {
+		switch method {
+		case MethodRealtime:
+			scrapers = append(scrapers, NewRealtime(username, password))
+		case MethodPushPull:
+			scrapers = append(scrapers, NewPushPull(username, password))
+		case MethodProxyEndpoint:
+			s, err := NewProxy(username, password)
+			if err != nil {
+				return nil, err
+			}
+			scrapers = append(scrapers, s)
+		default:
+			return nil, fmt.Errorf("invalid integration method: %s", method)
+		}
+	}
+
+	if len(scrapers) == 1 {
+		return scrapers[0], nil
+	}
+
+	return NewFallback(scrapers...), nil
+}
+
+// IsTimeout checks if the error is caused by a timeout.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
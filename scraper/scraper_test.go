@@ -0,0 +1,212 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type scraperFunc func(ctx context.Context, req Request) (*Result, error)
+
+func (f scraperFunc) Scrape(ctx context.Context, req Request) (*Result, error) {
+	return f(ctx, req)
+}
+
+func newTestRealtime(server *httptest.Server) *RealtimeScraper {
+	return &RealtimeScraper{
+		C: &internal.Client{
+			BaseUrl: server.URL,
+			ApiCredentials: &internal.ApiCredentials{
+				Username: "username",
+				Password: "password",
+			},
+			HttpClient: server.Client(),
+		},
+	}
+}
+
+func TestRealtimeScraper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "page": 1, "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	res, err := newTestRealtime(server).Scrape(context.Background(), Request{
+		Source: oxylabs.GoogleUrl,
+		Input:  "https://www.google.com/search?q=adidas",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, MethodRealtime, res.Method)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	resp, err := res.SerpResp()
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+}
+
+func TestRealtimeScraper_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	s := newTestRealtime(server)
+	s.Timeout = 10 * time.Millisecond
+
+	_, err := s.Scrape(context.Background(), Request{Source: oxylabs.GoogleSearch, Input: "adidas"})
+	assert.True(t, IsTimeout(err))
+}
+
+func TestFallbackScraper(t *testing.T) {
+	var calls []string
+	timeout := scraperFunc(func(ctx context.Context, req Request) (*Result, error) {
+		calls = append(calls, "realtime")
+		return nil, context.DeadlineExceeded
+	})
+	success := scraperFunc(func(ctx context.Context, req Request) (*Result, error) {
+		calls = append(calls, "push_pull")
+		return &Result{Method: MethodPushPull}, nil
+	})
+
+	res, err := NewFallback(timeout, success).Scrape(context.Background(), Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, MethodPushPull, res.Method)
+	assert.Equal(t, []string{"realtime", "push_pull"}, calls)
+}
+
+func TestFallbackScraper_DoesNotFallBackOnOtherErrors(t *testing.T) {
+	failure := scraperFunc(func(ctx context.Context, req Request) (*Result, error) {
+		return nil, errors.New("invalid request")
+	})
+	unreachable := scraperFunc(func(ctx context.Context, req Request) (*Result, error) {
+		t.Fatal("fell back after a non-timeout error")
+		return nil, nil
+	})
+
+	_, err := NewFallback(failure, unreachable).Scrape(context.Background(), Request{})
+	assert.EqualError(t, err, "invalid request")
+}
+
+func TestProxyScraper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "mobile", r.Header.Get("x-oxylabs-user-agent-type"))
+		assert.Equal(t, "html", r.Header.Get("x-oxylabs-render"))
+		assert.Equal(t, "1", r.Header.Get("x-oxylabs-parse"))
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	s := &ProxyScraper{Client: server.Client()}
+	res, err := s.Scrape(context.Background(), Request{
+		Input: server.URL,
+		Params: oxylabs.Params{
+			"user_agent_type": oxylabs.UA_MOBILE,
+			"render":          oxylabs.HTML,
+			"parse":           true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, MethodProxyEndpoint, res.Method)
+	assert.Equal(t, "<html></html>", string(res.Body))
+
+	_, err = s.Scrape(context.Background(), Request{
+		Input:  server.URL,
+		Params: oxylabs.Params{"pages": 2},
+	})
+	var validationErr *oxylabs.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = s.Scrape(context.Background(), Request{Source: oxylabs.GoogleSearch, Input: "adidas"})
+	assert.Error(t, err)
+}
+
+func TestProxyScraper_SkipsTypedZeroValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("x-oxylabs-render"))
+		assert.Empty(t, r.Header.Get("x-oxylabs-user-agent-type"))
+	}))
+	defer server.Close()
+
+	s := &ProxyScraper{Client: server.Client()}
+	_, err := s.Scrape(context.Background(), Request{
+		Input: server.URL,
+		Params: oxylabs.Params{
+			"render":          oxylabs.Render(""),
+			"user_agent_type": oxylabs.UserAgent(""),
+		},
+	})
+	assert.NoError(t, err)
+}
+
+// hostTransport sends every request to the test server, whatever its host.
+type hostTransport struct {
+	server *httptest.Server
+}
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+func TestPushPullScraper(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "username", username)
+		assert.Equal(t, "password", password)
+		paths = append(paths, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/v1/queries":
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "<html></html>", "page": 1, "status_code": 200}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s := &PushPullScraper{
+		C: &internal.Client{
+			BaseUrl: server.URL + "/v1/queries",
+			ApiCredentials: &internal.ApiCredentials{
+				Username: "username",
+				Password: "password",
+			},
+			HttpClient: &http.Client{Transport: hostTransport{server}},
+		},
+	}
+	res, err := s.Scrape(context.Background(), Request{
+		Source: oxylabs.GoogleUrl,
+		Input:  "https://www.google.com/search?q=adidas",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, MethodPushPull, res.Method)
+	assert.Equal(t, "123", res.JobID)
+	assert.Equal(t, []string{
+		"POST /v1/queries",
+		"GET /v1/queries/123",
+		"GET /v1/queries/123/results",
+	}, paths)
+
+	resp, err := res.SerpResp()
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+}
@@ -2,12 +2,11 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // Accepted parameters for bing.
@@ -20,66 +19,59 @@ var BingSearchAcceptedDomainParameters = []oxylabs.Domain{
 	oxylabs.DOMAIN_TR,
 }
 
-// checkParameterValidity checks validity of ScrapeBingSearch parameters.
-func (opt *BingSearchOpts) checkParameterValidity() error {
-	if opt.Domain != "" && !internal.InList(opt.Domain, BingSearchAcceptedDomainParameters) {
-		return fmt.Errorf("invalid domain parameter: %s", opt.Domain)
-	}
-
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// checkParameterValidity checks validity of ScrapeBingUrl parameters.
-func (opt *BingUrlOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
+// bingSearchSource describes the bing_search source.
+var bingSearchSource = &internal.SourceSpec{
+	Source: oxylabs.BingSearch,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "domain", Type: internal.ValueString, Default: internal.DefaultDomain},
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "limit", Type: internal.ValueInt, Default: internal.DefaultLimit_SERP},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	Domains: BingSearchAcceptedDomainParameters,
 }
 
 // BingSearchOpts contains all the query parameters available for bing_search.
 type BingSearchOpts struct {
-	Domain            oxylabs.Domain
-	StartPage         int
-	Pages             int
-	Limit             int
-	Locale            oxylabs.Locale
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	CallbackUrl       string
-	Render            oxylabs.Render
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
+	Domain              oxylabs.Domain
+	StartPage           int
+	Pages               int
+	Limit               int
+	Locale              oxylabs.Locale
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	CallbackUrl         string
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the BingSearchOpts as request parameters.
+func (opt *BingSearchOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"domain":               opt.Domain,
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"limit":                opt.Limit,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"callback_url":         opt.CallbackUrl,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
 }
 
 // ScrapeBingSearch scrapes bing via Oxylabs SERP API with bing_search as source.
@@ -93,6 +85,15 @@ func (c *SerpClient) ScrapeBingSearch(
 	return c.ScrapeBingSearchCtx(ctx, query, opts...)
 }
 
+// BuildBingSearchPayload returns the JSON payload that ScrapeBingSearch would send
+// for the given query and options, without sending it.
+func BuildBingSearchPayload(
+	query string,
+	opts ...*BingSearchOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.BingSearch, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeBingSearchCtx scrapes bing via Oxylabs SERP API with bing_search as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeBingSearchCtx(
@@ -100,78 +101,70 @@ func (c *SerpClient) ScrapeBingSearchCtx(
 	query string,
 	opts ...*BingSearchOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &BingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-		"render":          opt.Render,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.BingSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeBingSearchParsed scrapes bing via Oxylabs SERP API with bing_search as source
+// and returns the parsed content of the results as BingSearchContent.
+func (c *SerpClient) ScrapeBingSearchParsed(
+	query string,
+	opts ...*BingSearchOpts,
+) (*response.Resp[BingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeBingSearchParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeBingSearchParsedCtx scrapes bing via Oxylabs SERP API with bing_search as source
+// and returns the parsed content of the results as BingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeBingSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) (*response.Resp[BingSearchContent], error) {
+	return scrapeParsed[BingSearchContent](ctx, c, oxylabs.BingSearch, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// bingUrlSource describes the bing source.
+var bingUrlSource = &internal.SourceSpec{
+	Source: oxylabs.BingUrl,
+	Input:  internal.InputUrl,
+	Host:   "bing",
+	Params: []internal.Param{
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // BingUrlOpts contains all the query parameters available for bing.
 type BingUrlOpts struct {
-	UserAgent         oxylabs.UserAgent
-	GeoLocation       string
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
+	UserAgent           oxylabs.UserAgent
+	GeoLocation         string
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+}
+
+// params returns the BingUrlOpts as request parameters.
+func (opt *BingUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"user_agent_type":      opt.UserAgent,
+		"geo_location":         opt.GeoLocation,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
 }
 
 // ScrapeBingUrl scrapes bing via Oxylabs SERP API with bing as source.
@@ -185,6 +178,15 @@ func (c *SerpClient) ScrapeBingUrl(
 	return c.ScrapeBingUrlCtx(ctx, url, opts...)
 }
 
+// BuildBingUrlPayload returns the JSON payload that ScrapeBingUrl would send
+// for the given url and options, without sending it.
+func BuildBingUrlPayload(
+	url string,
+	opts ...*BingUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.BingUrl, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeBingUrlCtx scrapes bing via Oxylabs SERP API with bing as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeBingUrlCtx(
@@ -192,62 +194,28 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 	url string,
 	opts ...*BingUrlOpts,
 ) (*Resp, error) {
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "bing")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &BingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"geo_location":    opt.GeoLocation,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.Scrape(ctx, oxylabs.BingUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeBingUrlParsed scrapes bing via Oxylabs SERP API with bing as source
+// and returns the parsed content of the results as BingSearchContent.
+func (c *SerpClient) ScrapeBingUrlParsed(
+	url string,
+	opts ...*BingUrlOpts,
+) (*response.Resp[BingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeBingUrlParsedCtx(ctx, url, opts...)
+}
 
-	return resp, nil
+// ScrapeBingUrlParsedCtx scrapes bing via Oxylabs SERP API with bing as source
+// and returns the parsed content of the results as BingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeBingUrlParsedCtx(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) (*response.Resp[BingSearchContent], error) {
+	return scrapeParsed[BingSearchContent](ctx, c, oxylabs.BingUrl, url, internal.LastOpt(opts).params())
 }
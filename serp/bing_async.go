@@ -2,12 +2,10 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // ScrapeBingSearch scrapes bing with async polling runtime via Oxylabs SERP API
@@ -30,93 +28,30 @@ func (c *SerpClientAsync) ScrapeBingSearchCtx(
 	query string,
 	opts ...*BingSearchOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &BingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-		"render":          opt.Render,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.BingSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeBingSearchParsed scrapes bing with async polling runtime via Oxylabs SERP API
+// and bing_search as source, and returns the parsed content of the results as BingSearchContent.
+func (c *SerpClientAsync) ScrapeBingSearchParsed(
+	query string,
+	opts ...*BingSearchOpts,
+) (chan *response.Resp[BingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.ScrapeBingSearchParsedCtx(ctx, query, opts...)
+}
 
-	return respChan, nil
+// ScrapeBingSearchParsedCtx scrapes bing with async polling runtime via Oxylabs SERP API
+// and bing_search as source, and returns the parsed content of the results as BingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) ScrapeBingSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) (chan *response.Resp[BingSearchContent], error) {
+	return scrapeParsedAsync[BingSearchContent](ctx, c, oxylabs.BingSearch, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeBingUrl scrapes bing with async polling runtime via Oxylabs SERP API
@@ -139,88 +74,28 @@ func (c *SerpClientAsync) ScrapeBingUrlCtx(
 	url string,
 	opts ...*BingUrlOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "bing")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &BingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"geo_location":    opt.GeoLocation,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.BingUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeBingUrlParsed scrapes bing with async polling runtime via Oxylabs SERP API
+// and bing as source, and returns the parsed content of the results as BingSearchContent.
+func (c *SerpClientAsync) ScrapeBingUrlParsed(
+	url string,
+	opts ...*BingUrlOpts,
+) (chan *response.Resp[BingSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.ScrapeBingUrlParsedCtx(ctx, url, opts...)
+}
 
-	return respChan, nil
+// ScrapeBingUrlParsedCtx scrapes bing with async polling runtime via Oxylabs SERP API
+// and bing as source, and returns the parsed content of the results as BingSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) ScrapeBingUrlParsedCtx(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) (chan *response.Resp[BingSearchContent], error) {
+	return scrapeParsedAsync[BingSearchContent](ctx, c, oxylabs.BingUrl, url, internal.LastOpt(opts).params())
 }
@@ -0,0 +1,40 @@
+package serp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+func FuzzContextValidation(f *testing.F) {
+	f.Add([]byte(`{"tbm": 1, "filter": "1"}`))
+	f.Add([]byte(`{"hotel_occupancy": 2.5, "hotel_classes": [3, "4"]}`))
+	f.Add([]byte(`{"hotel_classes": 3, "hotel_dates": ["2024-01-01"]}`))
+	f.Add([]byte(`{"search_type": null, "category_id": "1", "date_from": 2024}`))
+	f.Add([]byte(`{"limit_per_page": [{"page": 1, "limit": "a"}], "nfpr": 0}`))
+	f.Add([]byte(`{"safe_search": "yes", "results_language": {}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return
+		}
+
+		context := []func(oxylabs.ContextOption){
+			func(ctx oxylabs.ContextOption) {
+				for key, value := range decoded {
+					ctx[key] = value
+				}
+			},
+		}
+
+		// Only panics are failures, validation errors are expected.
+		BuildGoogleSearchPayload("query", &GoogleSearchOpts{Context: context})
+		BuildGoogleAdsPayload("query", &GoogleAdsOpts{Context: context})
+		BuildGoogleHotelsPayload("query", &GoogleHotelsOpts{Context: context})
+		BuildGoogleTravelHotelsPayload("query", &GoogleTravelHotelsOpts{Context: context})
+		BuildGoogleImagesPayload("https://www.google.com/search?q=query", &GoogleImagesOpts{Context: context})
+		BuildGoogleTrendsExplorePayload("query", &GoogleTrendsExploreOpts{Context: context})
+	})
+}
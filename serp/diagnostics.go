@@ -0,0 +1,34 @@
+package serp
+
+import (
+	"reflect"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// contentModels contains the models of the parsed content of the sources
+// that have a typed response.
+var contentModels = map[oxylabs.Source]reflect.Type{
+	oxylabs.GoogleSearch: reflect.TypeOf(GoogleSearchContent{}),
+	oxylabs.GoogleUrl:    reflect.TypeOf(GoogleSearchContent{}),
+	oxylabs.GoogleAds:    reflect.TypeOf(GoogleSearchContent{}),
+	oxylabs.BingSearch:   reflect.TypeOf(BingSearchContent{}),
+	oxylabs.BingUrl:      reflect.TypeOf(BingSearchContent{}),
+}
+
+// Diagnose compares the JSON of the parsed content of the results and of the
+// job with the structs they are decoded into, and returns the unknown fields,
+// the type mismatches and the missing fields. The content is compared with the
+// typed content of the source, e.g. GoogleSearchContent. Missing fields are
+// not reported for sources without a typed content, since Content contains
+// the fields of every source.
+func (r *Resp) Diagnose() []oxylabs.Diagnostic {
+	return diagnose(&r.Resp)
+}
+
+// diagnose returns the diagnostics of the response with the models of the
+// sources, see Resp.Diagnose.
+func diagnose(r *response.Resp[Content]) []oxylabs.Diagnostic {
+	return r.DiagnoseWith(contentModels)
+}
@@ -0,0 +1,89 @@
+package serp
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// diagnosticsGolden compares the diagnostics with the golden file of the fixture.
+func diagnosticsGolden(t *testing.T, fixture string, diagnostics []oxylabs.Diagnostic) {
+	var b strings.Builder
+	for _, d := range diagnostics {
+		b.WriteString(d.String() + "\n")
+	}
+
+	golden := filepath.Join("testdata", strings.TrimSuffix(fixture, ".json")+".diagnostics.golden")
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, []byte(b.String()), 0644))
+	}
+
+	want, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), b.String())
+}
+
+func TestResp_Diagnose(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "google_search.json"))
+	assert.NoError(t, err)
+
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true, StrictDecode: true}}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+	organic := resp.Results[0].ContentParsed.Results.Organic[1]
+	assert.Equal(t, oxylabs.NewInt(3), organic.PosOverall)
+	assert.Empty(t, organic.Sitelinks)
+	assert.Contains(t, string(resp.Results[0].ContentJSON), `"sitelinks": []`)
+	diagnosticsGolden(t, "google_search.json", resp.Diagnose())
+}
+
+func TestSerpClient_EnableStrictDecode(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "google_search.json"))
+	assert.NoError(t, err)
+
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	})
+
+	// The sitelinks array fails the decoding outside of the strict decode mode.
+	_, err = c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Parse: true})
+	assert.ErrorContains(t, err, "sitelinks")
+
+	var logged []oxylabs.Diagnostic
+	c.EnableStrictDecode(func(diagnostics []oxylabs.Diagnostic) {
+		logged = append(logged, diagnostics...)
+	})
+
+	resp, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Parse: true})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Diagnostics)
+	assert.Equal(t, resp.Diagnostics, logged)
+}
+
+func TestResp_DiagnoseOptionalFields(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(`{
+		"results": [{"content": {"url": "https://www.google.com/search?q=adidas", "page": 1, "results": {}}}],
+		"job": {"source": "google_search"}
+	}`)))
+	paths := []string{}
+	for _, d := range resp.Diagnose() {
+		paths = append(paths, d.Path)
+	}
+	assert.Contains(t, paths, "/results/0/content/results/organic")
+	assert.NotContains(t, paths, "/results/0/content/results/paid")
+
+	// Optional fields are still marshalled when empty.
+	b, err := json.Marshal(GoogleSearchResults{})
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"paid":null`)
+}
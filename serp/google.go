@@ -2,12 +2,12 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // Accepted Parameters for context options in google.
@@ -30,197 +30,117 @@ var AcceptedSearchTypeParameters = []string{
 	"youtube_search",
 }
 
-// checkParameterValidity checks validity of ScrapeGoogleSearch parameters.
-func (opt *GoogleSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
-	}
-
-	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleUrl parameters.
-func (opt *GoogleUrlOpts) checkParameterValidity() error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
+// validateTbm checks validity of the tbm context option.
+func validateTbm(params map[string]interface{}, ctx oxylabs.ContextOption) error {
+	if tbm, ok := ctx["tbm"].(string); ok && !internal.InList(tbm, AcceptedTbmParameters) {
+		return fmt.Errorf("invalid tbm parameter: %v", tbm)
 	}
 
 	return nil
 }
 
-// checkParameterValidity checks validity of ScrapeGoogleAds parameters.
-func (opt *GoogleAdsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+// validateHotels checks validity of the hotel_occupancy and hotel_classes context options.
+func validateHotels(params map[string]interface{}, ctx oxylabs.ContextOption) error {
+	if occupancy, ok := ctx["hotel_occupancy"].(int); ok && occupancy < 0 {
+		return fmt.Errorf("invalid hotel_occupancy parameter: %v", occupancy)
 	}
 
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleHotels parameters.
-func (opt *GoogleHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
-	}
-
-	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// checkParameterValidity checks validity of ScrapeGoogleTravelHotels parameters.
-func (opt *GoogleTravelHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
-	}
-
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.StartPage <= 0 {
-		return fmt.Errorf("start_page must be greater than 0")
-	}
-
-	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
-	}
-
-	if ctx["hotel_classes"] != nil {
-		for _, value := range ctx["hotel_classes"].([]int) {
+	if classes, ok := ctx["hotel_classes"].([]int); ok {
+		for _, value := range classes {
 			if value < 2 || value > 5 {
 				return fmt.Errorf("invalid hotel_classes parameter: %v", value)
 			}
 		}
 	}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
 	return nil
 }
 
-// checkParameterValidity checks validity of ScrapeGoogleTrendsExplore parameters.
-func (opt *GoogleTrendsExploreOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
-	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+// validateTrendsExplore checks validity of the search_type and category_id context options.
+func validateTrendsExplore(params map[string]interface{}, ctx oxylabs.ContextOption) error {
+	if searchType, ok := ctx["search_type"].(string); ok && !internal.InList(searchType, AcceptedSearchTypeParameters) {
+		return fmt.Errorf("invalid search_type parameter: %v", searchType)
 	}
 
-	if ctx["search_type"] != nil && !internal.InList(ctx["search_type"].(string), AcceptedSearchTypeParameters) {
-		return fmt.Errorf("invalid search_type parameter: %v", ctx["search_type"])
-	}
-
-	if ctx["category_id"] != nil && ctx["category_id"].(int) < 0 {
+	if categoryId, ok := ctx["category_id"].(int); ok && categoryId < 0 {
 		return fmt.Errorf("invalid category_id")
 	}
 
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
-
 	return nil
 }
 
-// checkParameterValidity checks validity of ScrapeGoogleImages parameters.
-func (opt *GoogleImagesOpts) checkParameterValidity() error {
-	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
-	}
-
-	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
-	}
-
-	if opt.ParseInstructions != nil {
-		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
-		}
-	}
+// googleSearchContextKeys contains the context options accepted by GoogleSearchOpts.
+var googleSearchContextKeys = []internal.ContextKey{
+	{Name: "results_language", Type: internal.ValueString},
+	{Name: "filter", Type: internal.ValueInt},
+	{Name: "nfpr", Type: internal.ValueBool},
+	{Name: "safe_search", Type: internal.ValueBool},
+	{Name: "fpstate", Type: internal.ValueString},
+	{Name: "tbm", Type: internal.ValueString},
+	{Name: "tbs", Type: internal.ValueString},
+	{
+		Name:     "limit_per_page",
+		Type:     internal.ValuePageLimits,
+		TopLevel: true,
+		Replaces: []string{"limit", "start_page", "pages"},
+	},
+}
 
-	return nil
+// googleSearchSource describes the google_search source.
+var googleSearchSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleSearch,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "limit", Type: internal.ValueInt, Default: internal.DefaultLimit_SERP},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: googleSearchContextKeys,
+	Validate:    validateTbm,
 }
 
 // GoogleSearchOpts contains all the query parameters available for google_search.
 type GoogleSearchOpts struct {
-	StartPage         int
-	Pages             int
-	Limit             int
-	Locale            oxylabs.Locale
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
+	StartPage           int
+	Pages               int
+	Limit               int
+	Locale              oxylabs.Locale
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
+
+// params returns the GoogleSearchOpts as request parameters.
+func (opt *GoogleSearchOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"limit":                opt.Limit,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+	}
 }
 
 // ScrapeGoogleSearch scrapes google via Oxylabs SERP API with google_search as source.
@@ -234,6 +154,15 @@ func (c *SerpClient) ScrapeGoogleSearch(
 	return c.ScrapeGoogleSearchCtx(ctx, query, opts...)
 }
 
+// BuildGoogleSearchPayload returns the JSON payload that ScrapeGoogleSearch would send
+// for the given query and options, without sending it.
+func BuildGoogleSearchPayload(
+	query string,
+	opts ...*GoogleSearchOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleSearch, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleSearchCtx scrapes google via Oxylabs SERP API with google_search as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleSearchCtx(
@@ -241,125 +170,70 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 	query string,
 	opts ...*GoogleSearchOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Check if limit_per_page context parameter is used together with limit, start_page or pages parameters.
-	if (opt.Limit != 0 || opt.StartPage != 0 || opt.Pages != 0) && context["limit_per_page"] != nil {
-		return nil, fmt.Errorf(
-			"limit, start_page and pages parameters cannot be used together with limit_per_page context parameter",
-		)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSearch,
-		"query":           query,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "filter",
-				"value": context["filter"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "safe_search",
-				"value": context["safe_search"],
-			},
-			{
-				"key":   "fpstate",
-				"value": context["fpstate"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
-
-	// If user sends limit_per_page context parameter, use it instead of limit, start_page, and pages parameters.
-	if context["limit_per_page"] != nil {
-		payload["limit_per_page"] = context["limit_per_page"]
-	} else {
-		payload["start_page"] = opt.StartPage
-		payload["pages"] = opt.Pages
-		payload["limit"] = opt.Limit
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Scrape(ctx, oxylabs.GoogleSearch, query, internal.LastOpt(opts).params())
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+// ScrapeGoogleSearchParsed scrapes google via Oxylabs SERP API with google_search as source
+// and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClient) ScrapeGoogleSearchParsed(
+	query string,
+	opts ...*GoogleSearchOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.ScrapeGoogleSearchParsedCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleSearchParsedCtx scrapes google via Oxylabs SERP API with google_search as source
+// and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	return scrapeParsed[GoogleSearchContent](ctx, c, oxylabs.GoogleSearch, query, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// googleUrlSource describes the google source.
+var googleUrlSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleUrl,
+	Input:  internal.InputUrl,
+	Host:   "google",
+	Params: []internal.Param{
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
 }
 
 // GoogleUrlOpts contains all the query parameters available for google.
 type GoogleUrlOpts struct {
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	CallbackUrl       string
-	PollInterval      time.Duration
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	CallbackUrl         string
+	PollInterval        time.Duration
+}
+
+// params returns the GoogleUrlOpts as request parameters.
+func (opt *GoogleUrlOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"poll_interval":        opt.PollInterval,
+	}
 }
 
 // ScrapeGoogleUrl scrapes google via Oxylabs SERP API with google as source.
@@ -373,6 +247,15 @@ func (c *SerpClient) ScrapeGoogleUrl(
 	return c.ScrapeGoogleUrlCtx(ctx, url, opts...)
 }
 
+// BuildGoogleUrlPayload returns the JSON payload that ScrapeGoogleUrl would send
+// for the given url and options, without sending it.
+func BuildGoogleUrlPayload(
+	url string,
+	opts ...*GoogleUrlOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleUrl, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleUrlCtx scrapes google via Oxylabs SERP API with google as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleUrlCtx(
@@ -380,79 +263,92 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 	url string,
 	opts ...*GoogleUrlOpts,
 ) (*Resp, error) {
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
+	return c.Scrape(ctx, oxylabs.GoogleUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+// ScrapeGoogleUrlParsed scrapes google via Oxylabs SERP API with google as source
+// and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClient) ScrapeGoogleUrlParsed(
+	url string,
+	opts ...*GoogleUrlOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.ScrapeGoogleUrlParsedCtx(ctx, url, opts...)
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleUrlParsedCtx scrapes google via Oxylabs SERP API with google as source
+// and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleUrlParsedCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	return scrapeParsed[GoogleSearchContent](ctx, c, oxylabs.GoogleUrl, url, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// googleAdsContextKeys contains the context options accepted by GoogleAdsOpts.
+var googleAdsContextKeys = []internal.ContextKey{
+	{Name: "results_language", Type: internal.ValueString},
+	{Name: "nfpr", Type: internal.ValueBool},
+	{Name: "tbm", Type: internal.ValueString},
+	{Name: "tbs", Type: internal.ValueString},
+}
 
-	return resp, nil
+// googleAdsSource describes the google_ads source.
+var googleAdsSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleAds,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: googleAdsContextKeys,
+	Validate:    validateTbm,
 }
 
 // GoogleAdsOpts contains all the query parameters available for google_ads.
 type GoogleAdsOpts struct {
-	StartPage         int
-	Pages             int
-	Locale            string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
+	StartPage           int
+	Pages               int
+	Locale              string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
+
+// params returns the GoogleAdsOpts as request parameters.
+func (opt *GoogleAdsOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+	}
 }
 
 // ScrapeGoogleAds scrapes google via Oxylabs SERP API with google_ads as source.
@@ -466,6 +362,15 @@ func (c *SerpClient) ScrapeGoogleAds(
 	return c.ScrapeGoogleAdsCtx(ctx, query, opts...)
 }
 
+// BuildGoogleAdsPayload returns the JSON payload that ScrapeGoogleAds would send
+// for the given query and options, without sending it.
+func BuildGoogleAdsPayload(
+	query string,
+	opts ...*GoogleAdsOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleAds, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleAdsCtx scrapes google via Oxylabs SERP API with google_ads as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleAdsCtx(
@@ -473,101 +378,92 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 	query string,
 	opts ...*GoogleAdsOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleAdsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleAds,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
+	return c.Scrape(ctx, oxylabs.GoogleAds, query, internal.LastOpt(opts).params())
+}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+// ScrapeGoogleAdsParsed scrapes google via Oxylabs SERP API with google_ads as source
+// and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClient) ScrapeGoogleAdsParsed(
+	query string,
+	opts ...*GoogleAdsOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	return c.ScrapeGoogleAdsParsedCtx(ctx, query, opts...)
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleAdsParsedCtx scrapes google via Oxylabs SERP API with google_ads as source
+// and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleAdsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) (*response.Resp[GoogleSearchContent], error) {
+	return scrapeParsed[GoogleSearchContent](ctx, c, oxylabs.GoogleAds, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// googleHotelsContextKeys contains the context options accepted by GoogleHotelsOpts.
+var googleHotelsContextKeys = []internal.ContextKey{
+	{Name: "results_language", Type: internal.ValueString},
+	{Name: "nfpr", Type: internal.ValueBool},
+	{Name: "hotel_occupancy", Type: internal.ValueInt, Default: 2},
+	{Name: "hotel_dates", Type: internal.ValueString},
+}
 
-	return resp, nil
+// googleHotelsSource describes the google_hotels source.
+var googleHotelsSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleHotels,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "limit", Type: internal.ValueInt, Default: internal.DefaultLimit_SERP},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+	},
+	ContextKeys: googleHotelsContextKeys,
+	Validate:    validateHotels,
 }
 
 // GoogleHotelsOpts contains all the query parameters available for google_hotels.
 type GoogleHotelsOpts struct {
-	StartPage         int
-	Pages             int
-	Limit             int
-	Locale            string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
+	StartPage           int
+	Pages               int
+	Limit               int
+	Locale              string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
+
+// params returns the GoogleHotelsOpts as request parameters.
+func (opt *GoogleHotelsOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"limit":                opt.Limit,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+	}
 }
 
 // ScrapeGoogleHotels scrapes google via Oxylabs SERP API with google_hotels as source.
@@ -581,6 +477,15 @@ func (c *SerpClient) ScrapeGoogleHotels(
 	return c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
 }
 
+// BuildGoogleHotelsPayload returns the JSON payload that ScrapeGoogleHotels would send
+// for the given query and options, without sending it.
+func BuildGoogleHotelsPayload(
+	query string,
+	opts ...*GoogleHotelsOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleHotels, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleHotelsCtx scrapes google via the google_hotels source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleHotelsCtx(
@@ -588,103 +493,62 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 	query string,
 	opts ...*GoogleHotelsOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultHotelOccupancy(context)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleHotels,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleHotels, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// googleTravelHotelsContextKeys contains the context options accepted by GoogleTravelHotelsOpts.
+var googleTravelHotelsContextKeys = []internal.ContextKey{
+	{Name: "hotel_occupancy", Type: internal.ValueInt, Default: 2},
+	{Name: "hotel_classes", Type: internal.ValueIntList},
+	{Name: "hotel_dates", Type: internal.ValueString},
+}
 
-	return resp, nil
+// googleTravelHotelsSource describes the google_travel_hotels source.
+var googleTravelHotelsSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleTravelHotels,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+	},
+	ContextKeys: googleTravelHotelsContextKeys,
+	Validate:    validateHotels,
 }
 
 // GoogleTravelHotelsOpts contains all the query parameters available for google_travel_hotels.
 type GoogleTravelHotelsOpts struct {
-	StartPage         int
-	Locale            string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
+	StartPage           int
+	Locale              string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
+
+// params returns the GoogleTravelHotelsOpts as request parameters.
+func (opt *GoogleTravelHotelsOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+	}
 }
 
 // ScrapeGoogleTravelHotels scrapes google via Oxylabs SERP API with google_travel_hotels as source.
@@ -698,6 +562,15 @@ func (c *SerpClient) ScrapeGoogleTravelHotels(
 	return c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
 }
 
+// BuildGoogleTravelHotelsPayload returns the JSON payload that ScrapeGoogleTravelHotels would send
+// for the given query and options, without sending it.
+func BuildGoogleTravelHotelsPayload(
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleTravelHotels, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleTravelHotelsCtx scrapes google via Oxylabs SERP API with google_travel_hotels as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
@@ -705,95 +578,67 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleTravelHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleTravelHotels,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_classes",
-				"value": context["hotel_classes"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleTravelHotels, query, internal.LastOpt(opts).params())
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+// googleImagesContextKeys contains the context options accepted by GoogleImagesOpts.
+var googleImagesContextKeys = []internal.ContextKey{
+	{Name: "nfpr", Type: internal.ValueBool},
+	{Name: "results_language", Type: internal.ValueString},
+}
 
-	return resp, nil
+// googleImagesSource describes the google_images source.
+var googleImagesSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleImages,
+	Input:  internal.InputQuery,
+	Host:   "google",
+	Params: []internal.Param{
+		{Name: "start_page", Type: internal.ValueInt, Default: internal.DefaultStartPage},
+		{Name: "pages", Type: internal.ValueInt, Default: internal.DefaultPages},
+		{Name: "locale", Type: internal.ValueString},
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "render", Type: internal.ValueString},
+		{Name: "callback_url", Type: internal.ValueString},
+		{Name: "parse", Type: internal.ValueBool},
+	},
+	ContextKeys: googleImagesContextKeys,
 }
 
 // GoogleImagesOpts contains all the query parameters available for google_images.
 type GoogleImagesOpts struct {
-	StartPage         int
-	Pages             int
-	Locale            string
-	GeoLocation       string
-	UserAgent         oxylabs.UserAgent
-	Render            oxylabs.Render
-	CallbackUrl       string
-	Parse             bool
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
-	Context           []func(oxylabs.ContextOption)
+	StartPage           int
+	Pages               int
+	Locale              string
+	GeoLocation         string
+	UserAgent           oxylabs.UserAgent
+	Render              oxylabs.Render
+	BrowserInstructions []oxylabs.BrowserInstruction
+	CallbackUrl         string
+	Parse               bool
+	ParseInstructions   *map[string]interface{}
+	PollInterval        time.Duration
+	Context             []func(oxylabs.ContextOption)
+	LenientContext      bool
+}
+
+// params returns the GoogleImagesOpts as request parameters.
+func (opt *GoogleImagesOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"start_page":           opt.StartPage,
+		"pages":                opt.Pages,
+		"locale":               opt.Locale,
+		"geo_location":         opt.GeoLocation,
+		"user_agent_type":      opt.UserAgent,
+		"render":               opt.Render,
+		"browser_instructions": opt.BrowserInstructions,
+		"callback_url":         opt.CallbackUrl,
+		"parse":                opt.Parse,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+	}
 }
 
 // ScrapeGoogleImages scrapes google via Oxylabs SERP API with google_images as source.
@@ -807,6 +652,15 @@ func (c *SerpClient) ScrapeGoogleImages(
 	return c.ScrapeGoogleImagesCtx(ctx, url, opts...)
 }
 
+// BuildGoogleImagesPayload returns the JSON payload that ScrapeGoogleImages would send
+// for the given url and options, without sending it.
+func BuildGoogleImagesPayload(
+	url string,
+	opts ...*GoogleImagesOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleImages, url, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleImagesCtx scrapes google via Oxylabs SERP API with google_images as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleImagesCtx(
@@ -814,92 +668,54 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 	url string,
 	opts ...*GoogleImagesOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleImagesOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleImages,
-		"query":           url,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
+	return c.Scrape(ctx, oxylabs.GoogleImages, url, internal.LastOpt(opts).params())
+}
 
-	return resp, nil
+// googleTrendsExploreContextKeys contains the context options accepted by GoogleTrendsExploreOpts.
+var googleTrendsExploreContextKeys = []internal.ContextKey{
+	{Name: "search_type", Type: internal.ValueString},
+	{Name: "date_from", Type: internal.ValueString},
+	{Name: "date_to", Type: internal.ValueString},
+	{Name: "category_id", Type: internal.ValueInt},
+}
 
+// googleTrendsExploreSource describes the google_trends_explore source.
+var googleTrendsExploreSource = &internal.SourceSpec{
+	Source: oxylabs.GoogleTrendsExplore,
+	Input:  internal.InputQuery,
+	Params: []internal.Param{
+		{Name: "geo_location", Type: internal.ValueString},
+		{Name: "user_agent_type", Type: internal.ValueString, Default: internal.DefaultUserAgent},
+		{Name: "callback_url", Type: internal.ValueString},
+	},
+	ContextKeys: googleTrendsExploreContextKeys,
+	Validate:    validateTrendsExplore,
 }
 
 // GoogleTrendsExploreOpts contains all the query parameters available for google_trends_explore.
 type GoogleTrendsExploreOpts struct {
 	GeoLocation       string
 	Context           []func(oxylabs.ContextOption)
+	LenientContext    bool
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// params returns the GoogleTrendsExploreOpts as request parameters.
+func (opt *GoogleTrendsExploreOpts) params() oxylabs.Params {
+	return oxylabs.Params{
+		"geo_location":         opt.GeoLocation,
+		"context":              opt.Context,
+		"lenient_context":      opt.LenientContext,
+		"user_agent_type":      opt.UserAgent,
+		"callback_url":         opt.CallbackUrl,
+		"parsing_instructions": opt.ParseInstructions,
+		"poll_interval":        opt.PollInterval,
+	}
+}
+
 // ScrapeGoogleTrendsExplore scrapes google via Oxylabs SERP API with google_trends_explore as source.
 func (c *SerpClient) ScrapeGoogleTrendsExplore(
 	query string,
@@ -911,6 +727,15 @@ func (c *SerpClient) ScrapeGoogleTrendsExplore(
 	return c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
 }
 
+// BuildGoogleTrendsExplorePayload returns the JSON payload that ScrapeGoogleTrendsExplore would send
+// for the given query and options, without sending it.
+func BuildGoogleTrendsExplorePayload(
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) ([]byte, error) {
+	return BuildPayload(oxylabs.GoogleTrendsExplore, query, internal.LastOpt(opts).params())
+}
+
 // ScrapeGoogleTrendsExploreCtx scrapes google via Oxylabs SERP API with google_trends_explore as source.
 // The provided context allows customization of the HTTP req, including setting timeouts.
 func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
@@ -918,82 +743,5 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
 ) (*Resp, error) {
-	// Prepare options.
-	opt := &GoogleTrendsExploreOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source": oxylabs.GoogleTrendsExplore,
-		"query":  query,
-		"context": []map[string]interface{}{
-			{
-				"key":   "search_type",
-				"value": context["search_type"],
-			},
-			{
-				"key":   "date_from",
-				"value": context["date_from"],
-			},
-			{
-				"key":   "date_to",
-				"value": context["date_to"],
-			},
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-		},
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add geo_location to the payload if provided.
-	if opt.GeoLocation != "" {
-		payload["geo_location"] = opt.GeoLocation
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return c.Scrape(ctx, oxylabs.GoogleTrendsExplore, query, internal.LastOpt(opts).params())
 }
@@ -2,12 +2,10 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
 // ScrapeGoogleSearch scrapes google with async polling runtime via Oxylabs SERP API
@@ -30,140 +28,30 @@ func (c *SerpClientAsync) ScrapeGoogleSearchCtx(
 	query string,
 	opts ...*GoogleSearchOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Check if limit_per_page context parameter is used together with limit, start_page or pages parameters.
-	if (opt.Limit != 0 || opt.StartPage != 0 || opt.Pages != 0) && context["limit_per_page"] != nil {
-		return nil, fmt.Errorf(
-			"limit, start_page and pages parameters cannot be used together with limit_per_page context parameter",
-		)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSearch,
-		"query":           query,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "filter",
-				"value": context["filter"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "safe_search",
-				"value": context["safe_search"],
-			},
-			{
-				"key":   "fpstate",
-				"value": context["fpstate"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
-
-	// If user sends limit_per_page context parameter, use it instead of limit, start_page, and pages parameters.
-	if context["limit_per_page"] != nil {
-		payload["limit_per_page"] = context["limit_per_page"]
-	} else {
-		payload["start_page"] = opt.StartPage
-		payload["pages"] = opt.Pages
-		payload["limit"] = opt.Limit
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleSearch, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeGoogleSearchParsed scrapes google with async polling runtime via Oxylabs SERP API
+// and google_search as source, and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClientAsync) ScrapeGoogleSearchParsed(
+	query string,
+	opts ...*GoogleSearchOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeGoogleSearchParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleSearchParsedCtx scrapes google with async polling runtime via Oxylabs SERP API
+// and google_search as source, and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) ScrapeGoogleSearchParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	return scrapeParsedAsync[GoogleSearchContent](ctx, c, oxylabs.GoogleSearch, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleUrl scrapes google with async polling runtime via Oxylabs SERP API
@@ -186,90 +74,30 @@ func (c *SerpClientAsync) ScrapeGoogleUrlCtx(
 	url string,
 	opts ...*GoogleUrlOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleUrl, url, internal.LastOpt(opts).params())
+}
+
+// ScrapeGoogleUrlParsed scrapes google with async polling runtime via Oxylabs SERP API
+// and google as source, and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClientAsync) ScrapeGoogleUrlParsed(
+	url string,
+	opts ...*GoogleUrlOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeGoogleUrlParsedCtx(ctx, url, opts...)
+}
+
+// ScrapeGoogleUrlParsedCtx scrapes google with async polling runtime via Oxylabs SERP API
+// and google as source, and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) ScrapeGoogleUrlParsedCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	return scrapeParsedAsync[GoogleSearchContent](ctx, c, oxylabs.GoogleUrl, url, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleAds scrapes google with async polling runtime via Oxylabs SERP API
@@ -292,112 +120,30 @@ func (c *SerpClientAsync) ScrapeGoogleAdsCtx(
 	query string,
 	opts ...*GoogleAdsOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleAdsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleAds,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleAds, query, internal.LastOpt(opts).params())
+}
+
+// ScrapeGoogleAdsParsed scrapes google with async polling runtime via Oxylabs SERP API
+// and google_ads as source, and returns the parsed content of the results as GoogleSearchContent.
+func (c *SerpClientAsync) ScrapeGoogleAdsParsed(
+	query string,
+	opts ...*GoogleAdsOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	defer cancel()
+
+	return c.ScrapeGoogleAdsParsedCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleAdsParsedCtx scrapes google with async polling runtime via Oxylabs SERP API
+// and google_ads as source, and returns the parsed content of the results as GoogleSearchContent.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) ScrapeGoogleAdsParsedCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) (chan *response.Resp[GoogleSearchContent], error) {
+	return scrapeParsedAsync[GoogleSearchContent](ctx, c, oxylabs.GoogleAds, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleHotels scrapes google with async polling runtime via Oxylabs SERP API
@@ -420,115 +166,7 @@ func (c *SerpClientAsync) ScrapeGoogleHotelsCtx(
 	query string,
 	opts ...*GoogleHotelsOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleHotels,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleHotels, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleTravelHotels scrapes google with async polling runtime via Oxylabs SERP API
@@ -551,106 +189,7 @@ func (c *SerpClientAsync) ScrapeGoogleTravelHotelsCtx(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleTravelHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultStartPage(&opt.StartPage)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleTravelHotels,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_classes",
-				"value": context["hotel_classes"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleTravelHotels, query, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleImages scrapes google with async polling runtime via Oxylabs SERP API
@@ -673,105 +212,7 @@ func (c *SerpClientAsync) ScrapeGoogleImagesCtx(
 	url string,
 	opts ...*GoogleImagesOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleImagesOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleImages,
-		"query":           url,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleImages, url, internal.LastOpt(opts).params())
 }
 
 // ScrapeGoogleTrendsExplore scrapes google with async polling runtime via Oxylabs SERP API
@@ -794,104 +235,5 @@ func (c *SerpClientAsync) ScrapeGoogleTrendsExploreCtx(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
 ) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleTrendsExploreOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":       oxylabs.GoogleTrendsExplore,
-		"query":        query,
-		"geo_location": opt.GeoLocation,
-		"context": []map[string]interface{}{
-			{
-				"key":   "search_type",
-				"value": context["search_type"],
-			},
-			{
-				"key":   "date_from",
-				"value": context["date_from"],
-			},
-			{
-				"key":   "date_to",
-				"value": context["date_to"],
-			},
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-		},
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Scrape(ctx, oxylabs.GoogleTrendsExplore, query, internal.LastOpt(opts).params())
 }
@@ -0,0 +1,76 @@
+package serp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildGoogleSearchPayload(t *testing.T) {
+	jsonPayload, err := BuildGoogleSearchPayload(
+		"adidas",
+		&GoogleSearchOpts{
+			Parse: true,
+			Context: []func(oxylabs.ContextOption){
+				oxylabs.Tbm("isch"),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, "google_search", payload["source"])
+	assert.Equal(t, "adidas", payload["query"])
+	assert.Equal(t, true, payload["parse"])
+	assert.Equal(t, float64(1), payload["start_page"])
+	assert.Equal(t, float64(1), payload["pages"])
+	assert.Equal(t, float64(10), payload["limit"])
+	assert.Equal(t, "desktop", payload["user_agent_type"])
+	assert.Contains(t, payload["context"], map[string]interface{}{"key": "tbm", "value": "isch"})
+}
+
+func TestBuildGoogleSearchPayload_InvalidParameters(t *testing.T) {
+	_, err := BuildGoogleSearchPayload(
+		"adidas",
+		&GoogleSearchOpts{
+			Limit: 10,
+			Context: []func(oxylabs.ContextOption){
+				oxylabs.LimitPerPage([]oxylabs.PageLimit{{Page: 1, Limit: 5}}),
+			},
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestBuildGoogleUrlPayload_InvalidUrl(t *testing.T) {
+	_, err := BuildGoogleUrlPayload("https://www.example.com")
+	assert.Error(t, err)
+}
+
+func TestBuildGoogleSearchPayload_OmitsUnsetContext(t *testing.T) {
+	jsonPayload, err := BuildGoogleSearchPayload("adidas")
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.NotContains(t, payload, "context")
+
+	jsonPayload, err = BuildGoogleSearchPayload(
+		"adidas",
+		&GoogleSearchOpts{
+			Context: []func(oxylabs.ContextOption){
+				oxylabs.Nfpr(true),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	payload = nil
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "nfpr", "value": true},
+	}, payload["context"])
+}
@@ -0,0 +1,31 @@
+package serp
+
+import "github.com/oxylabs/oxylabs-sdk-go/response"
+
+// AllOrganic returns the organic results of every page of the response,
+// with their absolute position across the pages and without the results
+// whose URL already appeared on a previous page.
+func (r *Resp) AllOrganic() []response.Ranked[Organic] {
+	return response.Flatten(&r.Resp,
+		func(c Content) []Organic { return c.Results.Organic },
+		func(o Organic) string { return o.Url },
+	)
+}
+
+// AllPaid returns the paid results of every page of the response, ranked
+// and deduplicated like AllOrganic.
+func (r *Resp) AllPaid() []response.Ranked[Paid] {
+	return response.Flatten(&r.Resp,
+		func(c Content) []Paid { return c.Results.Paid },
+		func(p Paid) string { return p.Url },
+	)
+}
+
+// AllProducts returns the product listing ads of every page of the response,
+// ranked and deduplicated like AllOrganic.
+func (r *Resp) AllProducts() []response.Ranked[PlaItem] {
+	return response.Flatten(&r.Resp,
+		func(c Content) []PlaItem { return c.Results.Pla.Items },
+		func(p PlaItem) string { return p.Url },
+	)
+}
@@ -2,41 +2,36 @@ package serp
 
 import (
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
 )
 
-// Resp is the response struct for all serp sources.
+// Resp is the response struct for all serp sources. Its envelope and its
+// decoding are shared with the ecommerce package by response.Resp.
 type Resp struct {
-	Parse             bool      `json:"parse"`
-	ParseInstructions bool      `json:"parse_instructions"`
-	Results           []Results `json:"results"`
-	Job               Job       `json:"job"`
-	StatusCode        int       `json:"status_code"`
-	Status            string    `json:"status"`
-}
-
-type Results struct {
-	CustomContentParsed map[string]interface{}
-	ContentParsed       Content
-	Content             string
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
-	Page                int    `json:"page"`
-	Url                 string `json:"url"`
-	JobID               string `json:"job_id"`
-	StatusCode          int    `json:"status_code"`
-	ParserType          string `json:"parser_type"`
+	response.Resp[Content]
 }
 
+// Results is the result of a page of a serp response.
+type Results = response.Results[Content]
+
+// Content contains the parsed content of every serp source. The typed
+// responses returned by the Scrape*Parsed methods, e.g. GoogleSearchContent,
+// only contain the fields of their source.
 type Content struct {
 	Url             string      `json:"url"`
-	Page            int         `json:"page"`
+	Page            oxylabs.Int `json:"page"`
 	Errors          interface{} `json:"_errors"`
 	Results         Result      `json:"results"`
-	LastVisiblePage int         `json:"last_visible_page"`
-	ParseStatusCode int         `json:"parse_status_code"`
+	LastVisiblePage oxylabs.Int `json:"last_visible_page"`
+	ParseStatusCode oxylabs.Int `json:"parse_status_code"`
+	Warnings        []string    `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields of the content that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type Result struct {
@@ -65,26 +60,29 @@ type Result struct {
 	InstantAnswers             []InstantAnswers             `json:"instant_answers"`
 	VisuallySimilarImages      VisuallySimilarImages        `json:"visually_similar_images"`
 
-	TotalResultsCount int `json:"total_results_count"`
+	TotalResultsCount oxylabs.Int `json:"total_results_count"`
+
+	// Extra contains the results that are not mapped, e.g. new result types.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type Pla struct {
-	Items      []PlaItem `json:"items"`
-	PosOverall int       `json:"pos_overall,omitempty"`
+	Items      []PlaItem   `json:"items"`
+	PosOverall oxylabs.Int `json:"pos_overall,omitempty" oxy:"optional"`
 }
 
 type PlaItem struct {
-	Pos       int    `json:"pos"`
-	Url       string `json:"url"`
-	Price     string `json:"price"`
-	Title     string `json:"title"`
-	Seller    string `json:"seller"`
-	UrlImage  string `json:"url_image"`
-	ImageData string `json:"image_data"`
+	Pos       oxylabs.Int       `json:"pos"`
+	Url       string            `json:"url"`
+	Price     oxylabs.PriceText `json:"price"`
+	Title     string            `json:"title"`
+	Seller    string            `json:"seller"`
+	UrlImage  string            `json:"url_image"`
+	ImageData string            `json:"image_data"`
 }
 
 type Paid struct {
-	Pos        int           `json:"pos"`
+	Pos        oxylabs.Int   `json:"pos"`
 	Url        string        `json:"url"`
 	Desc       string        `json:"desc"`
 	Title      string        `json:"title"`
@@ -92,12 +90,12 @@ type Paid struct {
 	DataPcu    []string      `json:"data_pcu"`
 	Sitelinks  PaidSitelinks `json:"sitelinks"`
 	UrlShown   string        `json:"url_shown"`
-	PosOverall int           `json:"pos_overall"`
+	PosOverall oxylabs.Int   `json:"pos_overall"`
 }
 
 type PaidSitelinks struct {
-	Expanded []Expanded `json:"expanded,omitempty"`
-	Inline   []Inline   `json:"inline,omitempty"`
+	Expanded []Expanded `json:"expanded,omitempty" oxy:"optional"`
+	Inline   []Inline   `json:"inline,omitempty" oxy:"optional"`
 }
 
 type Expanded struct {
@@ -114,46 +112,46 @@ type Inline struct {
 
 type Image struct {
 	Items      []ImageItem `json:"items"`
-	PosOverall int         `json:"pos_overall"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type ImageItem struct {
-	Alt    string `json:"alt"`
-	Pos    int    `json:"pos"`
-	Url    string `json:"url"`
-	Data   string `json:"data"`
-	Source string `json:"source"`
+	Alt    string      `json:"alt"`
+	Pos    oxylabs.Int `json:"pos"`
+	Url    string      `json:"url"`
+	Data   string      `json:"data"`
+	Source string      `json:"source"`
 }
 
 type Organic struct {
-	Pos        int              `json:"pos"`
+	Pos        oxylabs.Int      `json:"pos"`
 	Url        string           `json:"url"`
 	Desc       string           `json:"desc"`
 	Title      string           `json:"title"`
 	Images     []string         `json:"images"`
-	Sitelinks  OrganicSitelinks `json:"sitelinks,omitempty"`
+	Sitelinks  OrganicSitelinks `json:"sitelinks,omitempty" oxy:"optional"`
 	UrlShown   string           `json:"url_shown"`
-	PosOverall int              `json:"pos_overall"`
+	PosOverall oxylabs.Int      `json:"pos_overall"`
 }
 
 type OrganicSitelinks struct {
-	Expanded []Expanded `json:"expanded,omitempty"`
-	Inline   []Inline   `json:"inline,omitempty"`
+	Expanded []Expanded `json:"expanded,omitempty" oxy:"optional"`
+	Inline   []Inline   `json:"inline,omitempty" oxy:"optional"`
 }
 
 type Twitter struct {
-	Pos        int           `json:"pos"`
+	Pos        oxylabs.Int   `json:"pos"`
 	Url        string        `json:"url"`
 	Items      []TwitterItem `json:"items"`
 	Title      string        `json:"title"`
-	PosOverall int           `json:"pos_overall"`
+	PosOverall oxylabs.Int   `json:"pos_overall"`
 }
 
 type TwitterItem struct {
-	Pos       int    `json:"pos"`
-	Url       string `json:"url"`
-	Content   string `json:"content"`
-	TimeFrame string `json:"time_frame"`
+	Pos       oxylabs.Int `json:"pos"`
+	Url       string      `json:"url"`
+	Content   string      `json:"content"`
+	TimeFrame string      `json:"time_frame"`
 }
 
 type Knowledge struct {
@@ -190,66 +188,66 @@ type RelatedSearch struct {
 
 type LocalPack struct {
 	Items      []LocalPackItem `json:"items"`
-	PosOverall int             `json:"pos_overall"`
+	PosOverall oxylabs.Int     `json:"pos_overall"`
 }
 
 type LocalPackItem struct {
-	Cid   string `json:"cid"`
-	Pos   int    `json:"pos"`
+	Cid   string      `json:"cid"`
+	Pos   oxylabs.Int `json:"pos"`
 	Links []struct {
 		Href  string `json:"href"`
 		Title string `json:"title"`
 	} `json:"links"`
-	Phone       string `json:"phone"`
-	Title       string `json:"title"`
-	Rating      int    `json:"rating"`
-	Address     string `json:"address"`
-	Subtitle    string `json:"subtitle"`
-	RatingCount int    `json:"rating_count"`
+	Phone       string      `json:"phone"`
+	Title       string      `json:"title"`
+	Rating      oxylabs.Int `json:"rating"`
+	Address     string      `json:"address"`
+	Subtitle    string      `json:"subtitle"`
+	RatingCount oxylabs.Int `json:"rating_count"`
 }
 
 type TopStory struct {
 	Items      []TopStoryItem `json:"items"`
-	PosOverall int            `json:"pos_overall"`
+	PosOverall oxylabs.Int    `json:"pos_overall"`
 }
 
 type TopStoryItem struct {
-	Pos       int    `json:"pos"`
-	Url       string `json:"url"`
-	Title     string `json:"title"`
-	Source    string `json:"source"`
-	TimeFrame string `json:"time_frame"`
+	Pos       oxylabs.Int `json:"pos"`
+	Url       string      `json:"url"`
+	Title     string      `json:"title"`
+	Source    string      `json:"source"`
+	TimeFrame string      `json:"time_frame"`
 }
 
 type PopularProducts struct {
 	Items      []PopularProductsItem `json:"items"`
-	PosOverall int                   `json:"pos_overall"`
+	PosOverall oxylabs.Int           `json:"pos_overall"`
 }
 
 type PopularProductsItem struct {
-	Pos       int    `json:"pos"`
-	Price     string `json:"price"`
-	Rating    string `json:"rating"`
-	Seller    string `json:"seller"`
-	Title     string `json:"title"`
-	ImageData string `json:"image_data"`
+	Pos       oxylabs.Int       `json:"pos"`
+	Price     oxylabs.PriceText `json:"price"`
+	Rating    string            `json:"rating"`
+	Seller    string            `json:"seller"`
+	Title     string            `json:"title"`
+	ImageData string            `json:"image_data"`
 }
 
 type RelatedSearches struct {
-	PosOverall      int      `json:"pos_overall"`
-	RelatedSearches []string `json:"related_searches"`
+	PosOverall      oxylabs.Int `json:"pos_overall"`
+	RelatedSearches []string    `json:"related_searches"`
 }
 
 type RelatedQuestions struct {
 	Items      []RelatedQuestionsItem `json:"items"`
-	PosOverall int                    `json:"pos_overall"`
+	PosOverall oxylabs.Int            `json:"pos_overall"`
 }
 
 type RelatedQuestionsItem struct {
-	Pos      int    `json:"pos"`
-	Answer   string `json:"answer"`
-	Source   Source `json:"source"`
-	Question string `json:"question"`
+	Pos      oxylabs.Int `json:"pos"`
+	Answer   string      `json:"answer"`
+	Source   Source      `json:"source"`
+	Question string      `json:"question"`
 }
 
 type Source struct {
@@ -261,68 +259,68 @@ type Source struct {
 type SearchInformation struct {
 	Image struct {
 		Url        string      `json:"url"`
-		Width      int         `json:"width"`
-		Height     int         `json:"height"`
+		Width      oxylabs.Int `json:"width"`
+		Height     oxylabs.Int `json:"height"`
 		OtherSizes interface{} `json:"other_sizes"`
 	} `json:"image"`
-	Query             string `json:"query"`
-	ShowingResultsFor string `json:"showing_results_for"`
-	TotalResultsCount int    `json:"total_results_count"`
+	Query             string      `json:"query"`
+	ShowingResultsFor string      `json:"showing_results_for"`
+	TotalResultsCount oxylabs.Int `json:"total_results_count"`
 }
 
 type ItemCarousel struct {
 	Items      []ItemCarouselItem `json:"items"`
 	Title      string             `json:"title"`
-	PosOverall int                `json:"pos_overall"`
+	PosOverall oxylabs.Int        `json:"pos_overall"`
 }
 
 type ItemCarouselItem struct {
-	Pos      int    `json:"pos"`
-	Href     string `json:"href"`
-	Title    string `json:"title"`
-	Subtitle string `json:"subtitle"`
+	Pos      oxylabs.Int `json:"pos"`
+	Href     string      `json:"href"`
+	Title    string      `json:"title"`
+	Subtitle string      `json:"subtitle"`
 }
 
 type Recipes struct {
 	Items      []RecipesItem `json:"items"`
-	PosOverall int           `json:"pos_overall"`
+	PosOverall oxylabs.Int   `json:"pos_overall"`
 }
 
 type RecipesItem struct {
-	Pos      int    `json:"pos"`
-	Url      string `json:"url"`
-	Desc     string `json:"desc"`
-	Title    string `json:"title"`
-	Rating   int    `json:"rating"`
-	Source   string `json:"source"`
-	Duration string `json:"duration"`
+	Pos      oxylabs.Int `json:"pos"`
+	Url      string      `json:"url"`
+	Desc     string      `json:"desc"`
+	Title    string      `json:"title"`
+	Rating   oxylabs.Int `json:"rating"`
+	Source   string      `json:"source"`
+	Duration string      `json:"duration"`
 }
 
 type Videos struct {
 	Items      []VideosItem `json:"items"`
-	PosOverall int          `json:"pos_overall"`
+	PosOverall oxylabs.Int  `json:"pos_overall"`
 }
 
 type VideosItem struct {
-	Pos    int    `json:"pos"`
-	Url    string `json:"url"`
-	Title  string `json:"title"`
-	Author string `json:"author"`
-	Source string `json:"source"`
+	Pos    oxylabs.Int `json:"pos"`
+	Url    string      `json:"url"`
+	Title  string      `json:"title"`
+	Author string      `json:"author"`
+	Source string      `json:"source"`
 }
 
 type FeaturedSnippet struct {
-	Url        string `json:"url"`
-	Desc       string `json:"desc"`
-	Title      string `json:"title"`
-	UrlShown   string `json:"url_shown"`
-	PosOverall int    `json:"pos_overall"`
+	Url        string      `json:"url"`
+	Desc       string      `json:"desc"`
+	Title      string      `json:"title"`
+	UrlShown   string      `json:"url_shown"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type RelatedSearchesCategorized struct {
 	Items      []RelatedSearchesCategorizedItem `json:"items"`
 	Category   Category                         `json:"category"`
-	PosOverall int                              `json:"pos_overall"`
+	PosOverall oxylabs.Int                      `json:"pos_overall"`
 }
 
 type RelatedSearchesCategorizedItem struct {
@@ -338,12 +336,12 @@ type Category struct {
 type Hotels struct {
 	DateTo  string `json:"date_to"`
 	Results []struct {
-		Price       string `json:"price"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
+		Price       oxylabs.PriceText `json:"price"`
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
 	} `json:"results"`
-	DateFrom   string `json:"date_from"`
-	PosOverall int    `json:"pos_overall"`
+	DateFrom   string      `json:"date_from"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type Flights struct {
@@ -351,44 +349,44 @@ type Flights struct {
 	From    string `json:"from"`
 	DateTo  string `json:"date_to"`
 	Results []struct {
-		Url      string `json:"url"`
-		Type     string `json:"type"`
-		Price    string `json:"price"`
-		Airline  string `json:"airline"`
-		Duration string `json:"duration"`
+		Url      string            `json:"url"`
+		Type     string            `json:"type"`
+		Price    oxylabs.PriceText `json:"price"`
+		Airline  string            `json:"airline"`
+		Duration string            `json:"duration"`
 	} `json:"results"`
-	DateFrom   string `json:"date_from"`
-	PosOverall int    `json:"pos_overall"`
+	DateFrom   string      `json:"date_from"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type VideoBox struct {
-	Url        string `json:"url"`
-	Title      string `json:"title"`
-	PosOverall int    `json:"pos_overall"`
+	Url        string      `json:"url"`
+	Title      string      `json:"title"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type LocalServiceAds struct {
 	Items []struct {
-		Pos              int    `json:"pos"`
-		Url              string `json:"url"`
-		Title            string `json:"title"`
-		Rating           int    `json:"rating"`
-		ReviewsCount     int    `json:"reviews_count"`
-		GoogleGuaranteed bool   `json:"google_guaranteed"`
+		Pos              oxylabs.Int `json:"pos"`
+		Url              string      `json:"url"`
+		Title            string      `json:"title"`
+		Rating           oxylabs.Int `json:"rating"`
+		ReviewsCount     oxylabs.Int `json:"reviews_count"`
+		GoogleGuaranteed bool        `json:"google_guaranteed"`
 	} `json:"items"`
-	PosOverall int `json:"pos_overall"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type Navigation struct {
-	Pos   int    `json:"pos"`
-	Url   string `json:"url"`
-	Title string `json:"title"`
+	Pos   oxylabs.Int `json:"pos"`
+	Url   string      `json:"url"`
+	Title string      `json:"title"`
 }
 
 type InstantAnswers struct {
-	Type       string `json:"type"`
-	Parsed     bool   `json:"_parsed"`
-	PosOverall int    `json:"pos_overall"`
+	Type       string      `json:"type"`
+	Parsed     bool        `json:"_parsed"`
+	PosOverall oxylabs.Int `json:"pos_overall"`
 }
 
 type VisuallySimilarImages struct {
@@ -396,145 +394,19 @@ type VisuallySimilarImages struct {
 	FeaturedImages []string `json:"featured_images"`
 }
 
-type Job struct {
-	CallbackUrl string `json:"callback_url"`
-	ClientID    int    `json:"client_id"`
-	Context     []struct {
-		Key   string      `json:"key"`
-		Value interface{} `json:"value"`
-	} `json:"context,omitempty"`
-	CreatedAt           string        `json:"created_at"`
-	Domain              string        `json:"domain"`
-	GeoLocation         interface{}   `json:"geo_location"`
-	ID                  string        `json:"id"`
-	Limit               int           `json:"limit"`
-	Locale              interface{}   `json:"locale"`
-	Pages               int           `json:"pages"`
-	Parse               bool          `json:"parse"`
-	ParserType          interface{}   `json:"parser_type"`
-	ParsingInstructions interface{}   `json:"parsing_instructions"`
-	BrowserInstructions interface{}   `json:"browser_instructions"`
-	Render              interface{}   `json:"render"`
-	Url                 interface{}   `json:"url"`
-	Query               string        `json:"query"`
-	Source              string        `json:"source"`
-	StartPage           int           `json:"start_page"`
-	Status              string        `json:"status"`
-	StorageType         interface{}   `json:"storage_type"`
-	StorageUrl          interface{}   `json:"storage_url"`
-	Subdomain           string        `json:"subdomain"`
-	ContentEncoding     string        `json:"content_encoding"`
-	UpdatedAt           string        `json:"updated_at"`
-	UserAgentType       string        `json:"user_agent_type"`
-	SessionInfo         interface{}   `json:"session_info"`
-	Statuses            []interface{} `json:"statuses"`
-	ClientNotes         interface{}   `json:"client_notes"`
-	Links               []struct {
-		Rel    string `json:"rel"`
-		Href   string `json:"href"`
-		Method string `json:"method"`
-	} `json:"_links,omitempty"`
-}
-
-// Custom function to unmarshal into the Resp struct.
-// Because of different return types depending on the parse option.
-func (r *Resp) UnmarshalJSON(data []byte) error {
-	// Unmarshal json data into RawResp map.
-	var rawResp map[string]json.RawMessage
-	if err := json.Unmarshal(data, &rawResp); err != nil {
-		return err
-	}
+// Job is the job of a response, shared by every source.
+type Job = oxylabs.Job
 
-	// Unmarshal the results array.
-	if resultsData, ok := rawResp["results"]; ok {
-		// Slice to store raw JSON messages for each result.
-		var resultsRawMessages []json.RawMessage
-		if err := json.Unmarshal(resultsData, &resultsRawMessages); err != nil {
-			return err
-		}
-
-		// Unmarshal each result into the Results slice.
-		for _, resultRawMessage := range resultsRawMessages {
-			if r.Parse && !r.ParseInstructions {
-				var result struct {
-					ContentParsed Content `json:"content"`
-					CreatedAt     string  `json:"created_at"`
-					UpdatedAt     string  `json:"updated_at"`
-					Page          int     `json:"page"`
-					Url           string  `json:"url"`
-					JobID         string  `json:"job_id"`
-					StatusCode    int     `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					ContentParsed: result.ContentParsed,
-					CreatedAt:     result.CreatedAt,
-					UpdatedAt:     result.UpdatedAt,
-					Page:          result.Page,
-					Url:           result.Url,
-					JobID:         result.JobID,
-					StatusCode:    result.StatusCode,
-				})
-			} else if r.Parse && r.ParseInstructions {
-				var result struct {
-					CustomContentParsed map[string]interface{} `json:"content"`
-					CreatedAt           string                 `json:"created_at"`
-					UpdatedAt           string                 `json:"updated_at"`
-					Page                int                    `json:"page"`
-					Url                 string                 `json:"url"`
-					JobID               string                 `json:"job_id"`
-					StatusCode          int                    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					CustomContentParsed: result.CustomContentParsed,
-					CreatedAt:           result.CreatedAt,
-					UpdatedAt:           result.UpdatedAt,
-					Page:                result.Page,
-					Url:                 result.Url,
-					JobID:               result.JobID,
-					StatusCode:          result.StatusCode,
-				})
-			} else if !r.Parse {
-				var result struct {
-					Content    string `json:"content"`
-					CreatedAt  string `json:"created_at"`
-					UpdatedAt  string `json:"updated_at"`
-					Page       int    `json:"page"`
-					Url        string `json:"url"`
-					JobID      string `json:"job_id"`
-					StatusCode int    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					Content:    result.Content,
-					CreatedAt:  result.CreatedAt,
-					UpdatedAt:  result.UpdatedAt,
-					Page:       result.Page,
-					Url:        result.Url,
-					JobID:      result.JobID,
-					StatusCode: result.StatusCode,
-				})
-			}
-		}
-	}
-
-	// Unmarshal the job object.
-	if jobData, ok := rawResp["job"]; ok {
-		var job Job
-		if err := json.Unmarshal(jobData, &job); err != nil {
-			return err
-		}
-		r.Job = job
-	}
+// UnmarshalJSON unmarshals the content and keeps the fields that are not mapped in Extra.
+func (c *Content) UnmarshalJSON(data []byte) error {
+	type content Content
+	return internal.UnmarshalExtra(data, (*content)(c), &c.Extra)
+}
 
-	return nil
+// UnmarshalJSON unmarshals the results and keeps the fields that are not mapped in Extra.
+func (r *Result) UnmarshalJSON(data []byte) error {
+	type result Result
+	return internal.UnmarshalExtra(data, (*result)(r), &r.Extra)
 }
 
 // GetResp returns a Resp struct from the http.Response object.
@@ -545,28 +417,16 @@ func GetResp(
 	parse bool,
 	customParserFlag bool,
 ) (*Resp, error) {
-	// Read the resp body into a buffer.
-	respBody, err := io.ReadAll(httpResp.Body)
+	res, err := response.GetResp[Content](httpResp, parse, customParserFlag)
 	if err != nil {
 		return nil, err
 	}
 
-	// If status code not 200, return error.
-	if httpResp.StatusCode != 200 {
-		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
-	}
-
-	// Unmarshal the JSON object.
-	res := &Resp{}
-	res.Parse = parse
-	res.ParseInstructions = customParserFlag
-	if err := res.UnmarshalJSON(respBody); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON object: %v", err)
-	}
-
-	// Set status code and status.
-	res.StatusCode = httpResp.StatusCode
-	res.Status = httpResp.Status
+	return &Resp{Resp: *res}, nil
+}
 
-	return res, nil
+// DecodeParsed decodes the CustomContentParsed of the results into the struct T,
+// whose parse instructions can be generated with oxylabs.ParseInstructionsFor.
+func DecodeParsed[T any](results Results) (T, error) {
+	return oxylabs.DecodeParsed[T](results.CustomContentParsed)
 }
@@ -0,0 +1,180 @@
+package serp
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+const parsedRespJSON = `{
+	"results": [{
+		"content": {
+			"url": "https://www.google.com/search?q=adidas",
+			"page": 1,
+			"results": {
+				"organic": [{"pos": 1, "url": "https://www.adidas.com", "title": "adidas"}],
+				"ai_overview": {"text": "adidas is a sportswear company."}
+			},
+			"_warnings": ["Could not parse videos."],
+			"parse_status_code": 12000,
+			"new_field": 1
+		},
+		"page": 1,
+		"status_code": 200,
+		"parser_type": "v2"
+	}],
+	"job": {"id": "123", "source": "google_search", "new_job_field": "value"}
+}`
+
+func TestResp_KeepsUnmappedFields(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(parsedRespJSON)))
+
+	result := resp.Results[0]
+	assert.Equal(t, "v2", result.ParserType)
+	assert.Equal(t, oxylabs.ParseStatusSuccess, result.ParseStatus)
+	assert.Equal(t, []string{"Could not parse videos."}, result.Warnings)
+	assert.Equal(t, []string{"Could not parse videos."}, result.ContentParsed.Warnings)
+	assert.Equal(t, "adidas", result.ContentParsed.Results.Organic[0].Title)
+
+	assert.JSONEq(t, `{"text": "adidas is a sportswear company."}`, string(result.ContentParsed.Results.Extra["ai_overview"]))
+	assert.Equal(t, json.RawMessage(`1`), result.ContentParsed.Extra["new_field"])
+	assert.NotContains(t, result.ContentParsed.Extra, "url")
+	assert.Equal(t, json.RawMessage(`"value"`), resp.Job.Extra["new_job_field"])
+
+	var content map[string]interface{}
+	assert.NoError(t, json.Unmarshal(result.ContentJSON, &content))
+	assert.Contains(t, content, "new_field")
+	assert.Contains(t, string(resp.JobJSON), "new_job_field")
+}
+
+func TestResp_ParseStatusOfParseInstructions(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true, ParseInstructions: true}}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(`{
+		"results": [{
+			"content": {"title": "adidas", "_warnings": ["warning"], "parse_status_code": 12004},
+			"parser_type": "custom"
+		}]
+	}`)))
+
+	result := resp.Results[0]
+	assert.Equal(t, "custom", result.ParserType)
+	assert.Equal(t, oxylabs.ParseStatusPartialSuccess, result.ParseStatus)
+	assert.Equal(t, []string{"warning"}, result.Warnings)
+	assert.Equal(t, "adidas", result.CustomContentParsed["title"])
+}
+
+func TestResp_UnparsedContent(t *testing.T) {
+	resp := &Resp{}
+	assert.NoError(t, resp.UnmarshalJSON([]byte(`{"results": [{"content": "<html></html>"}]}`)))
+
+	result := resp.Results[0]
+	assert.Equal(t, "<html></html>", result.Content)
+	assert.Equal(t, json.RawMessage(`"<html></html>"`), result.ContentJSON)
+	assert.Equal(t, oxylabs.ParseStatusUnset, result.ParseStatus)
+}
+
+const failedRespJSON = `{
+	"results": [
+		{
+			"content": {"url": "https://www.google.com/search?q=adidas", "page": 1, "parse_status_code": 12000},
+			"page": 1,
+			"url": "https://www.google.com/search?q=adidas",
+			"status_code": 200
+		},
+		{
+			"content": {"url": "https://www.google.com/search?q=adidas&start=10", "page": 2, "parse_status_code": 12002, "_errors": ["Could not parse the page."]},
+			"page": 2,
+			"url": "https://www.google.com/search?q=adidas&start=10",
+			"status_code": 200
+		}
+	],
+	"job": {"id": "123", "source": "google_search"}
+}`
+
+func TestResp_Failed(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, json.Unmarshal([]byte(failedRespJSON), resp))
+
+	failed := resp.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, 2, failed[0].Page)
+	assert.Equal(t, oxylabs.ParseStatusFailure, failed[0].ParseStatus)
+	assert.Equal(t, []interface{}{"Could not parse the page."}, failed[0].ParseErrors)
+}
+
+func TestSerpClient_StrictParse(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(failedRespJSON))
+	})
+	c.EnableStrictParse()
+
+	resp, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Parse: true})
+	assert.Nil(t, resp)
+
+	var parseErr *oxylabs.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "123", parseErr.JobID)
+	assert.Len(t, parseErr.Failures, 1)
+	assert.Equal(t, 2, parseErr.Failures[0].Page)
+	assert.EqualError(t, err, "failed to parse 1 pages of job 123: page 2: 12002 (failed to parse the page)")
+}
+
+func TestResp_Timestamps(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "google_search.json"))
+	assert.NoError(t, err)
+
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true, StrictDecode: true}}
+	assert.NoError(t, resp.UnmarshalJSON(data))
+
+	result := resp.Results[0]
+	assert.Equal(t, "2024-02-14 10:58:35", result.CreatedAt.Raw)
+	assert.Equal(t, 3*time.Second, result.UpdatedAt.Sub(result.CreatedAt.Time))
+	assert.Equal(t, time.Date(2024, 2, 14, 10, 58, 38, 0, time.UTC), resp.Job.UpdatedAt.Time)
+
+	_, ok := resp.Job.QueueTime()
+	assert.False(t, ok)
+}
+
+func TestResp_AllOrganic(t *testing.T) {
+	resp := &Resp{Resp: response.Resp[Content]{Parse: true}}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"results": [
+			{"content": {"results": {
+				"organic": [
+					{"pos": 1, "url": "https://www.adidas.com/us"},
+					{"pos": 2, "url": "https://en.wikipedia.org/wiki/Adidas"}
+				],
+				"paid": [{"pos": 1, "url": "https://www.adidas.com/us/shoes"}],
+				"pla": {"items": [{"pos": 1, "url": "https://www.adidas.com/us/samba", "price": "$100.00"}]}
+			}}, "page": 1},
+			{"content": {"results": {
+				"organic": [
+					{"pos": 1, "url": "https://adidas.com/us/?utm_source=google#top"},
+					{"pos": 2, "url": "https://www.nike.com"}
+				],
+				"paid": [{"pos": 1, "url": "https://www.adidas.com/us/shoes"}]
+			}}, "page": 2}
+		]
+	}`), resp))
+
+	organic := resp.AllOrganic()
+	assert.Len(t, organic, 3)
+	assert.Equal(t, "https://en.wikipedia.org/wiki/Adidas", organic[1].Item.Url)
+	assert.Equal(t, 3, organic[2].Pos)
+	assert.Equal(t, 2, organic[2].Page)
+	assert.Equal(t, oxylabs.NewInt(2), organic[2].Item.Pos)
+	assert.Equal(t, "https://nike.com", organic[2].Url)
+
+	assert.Len(t, resp.AllPaid(), 1)
+	products := resp.AllProducts()
+	assert.Len(t, products, 1)
+	assert.Equal(t, oxylabs.PriceText("$100.00"), products[0].Item.Price)
+}
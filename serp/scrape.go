@@ -0,0 +1,90 @@
+package serp
+
+import (
+	"context"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// sources contains the sources which can be scraped via Oxylabs SERP API.
+var sources = internal.RegisterSources(
+	googleSearchSource,
+	googleUrlSource,
+	googleAdsSource,
+	googleHotelsSource,
+	googleTravelHotelsSource,
+	googleImagesSource,
+	googleTrendsExploreSource,
+	bingSearchSource,
+	bingUrlSource,
+)
+
+// BuildPayload returns the JSON payload that Scrape would send
+// for the given source, input and params, without sending it.
+func BuildPayload(
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) ([]byte, error) {
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.JSON, nil
+}
+
+// Scrape scrapes the given source via Oxylabs SERP API.
+// The input is the query or the URL to scrape, depending on the source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) Scrape(
+	ctx context.Context,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (*Resp, error) {
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := response.Scrape(ctx, c.C, payload, false, diagnose)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resp{Resp: *res}, nil
+}
+
+// Scrape scrapes the given source with async polling runtime via Oxylabs SERP API.
+// The input is the query or the URL to scrape, depending on the source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClientAsync) Scrape(
+	ctx context.Context,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (chan *Resp, error) {
+	respChan := make(chan *Resp)
+
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := response.Scrape(ctx, c.C, payload, true, diagnose)
+	if err != nil {
+		return nil, err
+	}
+
+	// Forward the response to the resp channel.
+	go func() {
+		respChan <- &Resp{Resp: *res}
+	}()
+
+	return respChan, nil
+}
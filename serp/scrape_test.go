@@ -0,0 +1,137 @@
+package serp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPayload(t *testing.T) {
+	var params oxylabs.Params
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"pages": 2,
+		"parse": true,
+		"context": {"tbm": "nws", "nfpr": true}
+	}`), &params))
+
+	jsonPayload, err := BuildPayload(oxylabs.GoogleSearch, "adidas", params)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, "google_search", payload["source"])
+	assert.Equal(t, "adidas", payload["query"])
+	assert.Equal(t, float64(2), payload["pages"])
+	assert.Equal(t, float64(1), payload["start_page"])
+	assert.Equal(t, float64(10), payload["limit"])
+	assert.Equal(t, "desktop", payload["user_agent_type"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "nfpr", "value": true},
+		map[string]interface{}{"key": "tbm", "value": "nws"},
+	}, payload["context"])
+}
+
+func TestBuildPayload_UnsupportedSource(t *testing.T) {
+	_, err := BuildPayload(oxylabs.AmazonSearch, "adidas", nil)
+	assert.EqualError(t, err, "unsupported source: amazon_search")
+}
+
+func TestBuildPayload_UnsupportedParameter(t *testing.T) {
+	_, err := BuildPayload(oxylabs.GoogleTrendsExplore, "adidas", oxylabs.Params{"pages": 2})
+
+	var validationErr *oxylabs.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "pages", validationErr.Key)
+}
+
+func TestBuildPayload_ParsingInstructions(t *testing.T) {
+	instructions := map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []map[string]interface{}{
+				{"_fn": "xpath_one", "_args": []string{"//h1/text()"}},
+			},
+		},
+	}
+
+	for _, source := range []oxylabs.Source{oxylabs.GoogleSearch, oxylabs.BingSearch} {
+		jsonPayload, err := BuildPayload(source, "adidas", oxylabs.Params{
+			"parsing_instructions": instructions,
+		})
+		assert.NoError(t, err)
+
+		var payload map[string]interface{}
+		assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+		assert.Equal(t, true, payload["parse"])
+		assert.Contains(t, payload["parsing_instructions"], "title")
+	}
+}
+
+func TestBuildPayload_JSONParsingInstructions(t *testing.T) {
+	var instructions map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"links": {
+			"_fns": [
+				{"_fn": "xpath", "_args": ["//a/@href"]},
+				{"_fn": "select_nth", "_args": 1}
+			]
+		}
+	}`), &instructions))
+
+	_, err := BuildPayload(oxylabs.GoogleSearch, "adidas", oxylabs.Params{
+		"parsing_instructions": instructions,
+	})
+	assert.NoError(t, err)
+}
+
+func TestBuildPayload_BrowserInstructions(t *testing.T) {
+	instructions := []oxylabs.BrowserInstruction{
+		{Type: oxylabs.BrowserScrollToBottom},
+	}
+
+	jsonPayload, err := BuildPayload(oxylabs.GoogleSearch, "adidas", oxylabs.Params{
+		"render":               oxylabs.HTML,
+		"browser_instructions": instructions,
+	})
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "scroll_to_bottom"},
+	}, payload["browser_instructions"])
+
+	_, err = BuildGoogleSearchPayload("adidas", &GoogleSearchOpts{
+		BrowserInstructions: instructions,
+	})
+	var validationErr *oxylabs.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "browser_instructions", validationErr.Key)
+
+	_, err = BuildGoogleSearchPayload("adidas", &GoogleSearchOpts{
+		Render:              oxylabs.PNG,
+		BrowserInstructions: instructions,
+	})
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "browser_instructions", validationErr.Key)
+}
+
+func TestBuildPayload_LimitPerPage(t *testing.T) {
+	jsonPayload, err := BuildPayload(oxylabs.GoogleSearch, "adidas", oxylabs.Params{
+		"context": []func(oxylabs.ContextOption){
+			oxylabs.LimitPerPage([]oxylabs.PageLimit{{Page: 1, Limit: 5}}),
+		},
+	})
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(jsonPayload, &payload))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"page": float64(1), "limit": float64(5)},
+	}, payload["limit_per_page"])
+	assert.NotContains(t, payload, "limit")
+	assert.NotContains(t, payload, "start_page")
+	assert.NotContains(t, payload, "pages")
+	assert.NotContains(t, payload, "context")
+}
@@ -0,0 +1,75 @@
+package serp
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func readScreenshotFixture(t *testing.T) []byte {
+	data, err := os.ReadFile(filepath.Join("testdata", "screenshot.png"))
+	assert.NoError(t, err)
+	return data
+}
+
+func TestResults_Screenshot(t *testing.T) {
+	fixture := readScreenshotFixture(t)
+	results := Results{Content: base64.StdEncoding.EncodeToString(fixture)}
+
+	data, err := results.Screenshot()
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, data)
+
+	img, err := results.ScreenshotImage()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, img.Bounds().Dx())
+	assert.Equal(t, 2, img.Bounds().Dy())
+
+	path := filepath.Join(t.TempDir(), "screenshot.png")
+	assert.NoError(t, results.SaveScreenshot(path))
+	saved, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, saved)
+}
+
+func TestResults_ScreenshotInvalidContent(t *testing.T) {
+	results := Results{Content: "<html></html>"}
+	_, err := results.Screenshot()
+	assert.Error(t, err)
+
+	results = Results{Content: base64.StdEncoding.EncodeToString([]byte("<html></html>"))}
+	_, err = results.Screenshot()
+	assert.EqualError(t, err, "error decoding screenshot: content is not a png")
+}
+
+func TestSerpClientAsync_GetScreenshot(t *testing.T) {
+	fixture := readScreenshotFixture(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/queries/123/results", r.URL.Path)
+		assert.Equal(t, "png", r.URL.Query().Get("type"))
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	c := &SerpClientAsync{
+		C: &internal.Client{
+			BaseUrl: server.URL + "/v1/queries",
+			ApiCredentials: &internal.ApiCredentials{
+				Username: "username",
+				Password: "password",
+			},
+			HttpClient: server.Client(),
+		},
+	}
+
+	data, err := c.GetScreenshot(context.Background(), "123")
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, data)
+}
@@ -0,0 +1,162 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+)
+
+// GoogleSearchContent is the parsed content of the google_search, google
+// and google_ads sources.
+type GoogleSearchContent struct {
+	Url             string              `json:"url"`
+	Page            oxylabs.Int         `json:"page"`
+	Results         GoogleSearchResults `json:"results"`
+	LastVisiblePage oxylabs.Int         `json:"last_visible_page"`
+	ParseStatusCode oxylabs.Int         `json:"parse_status_code"`
+	Errors          interface{}         `json:"_errors" oxy:"optional"`
+	Warnings        []string            `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GoogleSearchResults contains the results of a google search page. Result
+// types which are only returned when they are on the page are optional.
+type GoogleSearchResults struct {
+	Pla                        Pla                          `json:"pla" oxy:"optional"`
+	Paid                       []Paid                       `json:"paid" oxy:"optional"`
+	Images                     Image                        `json:"images" oxy:"optional"`
+	Organic                    []Organic                    `json:"organic"`
+	Twitter                    Twitter                      `json:"twitter" oxy:"optional"`
+	Knowledge                  Knowledge                    `json:"knowledge" oxy:"optional"`
+	LocalPack                  LocalPack                    `json:"local_pack" oxy:"optional"`
+	TopStories                 TopStory                     `json:"top_stories" oxy:"optional"`
+	PopularProducts            []PopularProducts            `json:"popular_products" oxy:"optional"`
+	RelatedSearches            RelatedSearches              `json:"related_searches" oxy:"optional"`
+	RelatedQuestions           RelatedQuestions             `json:"related_questions" oxy:"optional"`
+	SearchInformation          SearchInformation            `json:"search_information"`
+	ItemCarousel               ItemCarousel                 `json:"item_carousel" oxy:"optional"`
+	Recipes                    Recipes                      `json:"recipes" oxy:"optional"`
+	Videos                     Videos                       `json:"videos" oxy:"optional"`
+	FeaturedSnippet            []FeaturedSnippet            `json:"featured_snippet" oxy:"optional"`
+	RelatedSearchesCategorized []RelatedSearchesCategorized `json:"related_searches_categorized" oxy:"optional"`
+	Hotels                     Hotels                       `json:"hotels" oxy:"optional"`
+	Flights                    Flights                      `json:"flights" oxy:"optional"`
+	VideoBox                   VideoBox                     `json:"video_box" oxy:"optional"`
+	LocalServiceAds            LocalServiceAds              `json:"local_service_ads" oxy:"optional"`
+	Navigation                 []Navigation                 `json:"navigation" oxy:"optional"`
+	InstantAnswers             []InstantAnswers             `json:"instant_answers" oxy:"optional"`
+	VisuallySimilarImages      VisuallySimilarImages        `json:"visually_similar_images" oxy:"optional"`
+	TotalResultsCount          oxylabs.Int                  `json:"total_results_count"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BingSearchContent is the parsed content of the bing_search and bing sources.
+type BingSearchContent struct {
+	Url             string            `json:"url"`
+	Page            oxylabs.Int       `json:"page"`
+	Results         BingSearchResults `json:"results"`
+	LastVisiblePage oxylabs.Int       `json:"last_visible_page"`
+	ParseStatusCode oxylabs.Int       `json:"parse_status_code"`
+	Errors          interface{}       `json:"_errors" oxy:"optional"`
+	Warnings        []string          `json:"_warnings,omitempty" oxy:"optional"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BingSearchResults contains the results of a bing search page. Result
+// types which are only returned when they are on the page are optional.
+type BingSearchResults struct {
+	Paid              []Paid            `json:"paid" oxy:"optional"`
+	Organic           []Organic         `json:"organic"`
+	Images            Image             `json:"images" oxy:"optional"`
+	Videos            Videos            `json:"videos" oxy:"optional"`
+	RelatedSearches   RelatedSearches   `json:"related_searches" oxy:"optional"`
+	SearchInformation SearchInformation `json:"search_information"`
+	TotalResultsCount oxylabs.Int       `json:"total_results_count"`
+
+	// Extra contains the fields that are not mapped.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON unmarshals the GoogleSearchContent and keeps the fields that are not mapped in Extra.
+func (g *GoogleSearchContent) UnmarshalJSON(data []byte) error {
+	type googleSearchContent GoogleSearchContent
+	return internal.UnmarshalExtra(data, (*googleSearchContent)(g), &g.Extra)
+}
+
+// UnmarshalJSON unmarshals the GoogleSearchResults and keeps the fields that are not mapped in Extra.
+func (g *GoogleSearchResults) UnmarshalJSON(data []byte) error {
+	type googleSearchResults GoogleSearchResults
+	return internal.UnmarshalExtra(data, (*googleSearchResults)(g), &g.Extra)
+}
+
+// UnmarshalJSON unmarshals the BingSearchContent and keeps the fields that are not mapped in Extra.
+func (b *BingSearchContent) UnmarshalJSON(data []byte) error {
+	type bingSearchContent BingSearchContent
+	return internal.UnmarshalExtra(data, (*bingSearchContent)(b), &b.Extra)
+}
+
+// UnmarshalJSON unmarshals the BingSearchResults and keeps the fields that are not mapped in Extra.
+func (b *BingSearchResults) UnmarshalJSON(data []byte) error {
+	type bingSearchResults BingSearchResults
+	return internal.UnmarshalExtra(data, (*bingSearchResults)(b), &b.Extra)
+}
+
+// scrapeParsed scrapes the source with parsing enabled and decodes
+// the parsed content of the results into T.
+func scrapeParsed[T any](
+	ctx context.Context,
+	c *SerpClient,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (*response.Resp[T], error) {
+	params["parse"] = true
+
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ScrapeParsed[T](ctx, c.C, payload, false)
+}
+
+// scrapeParsedAsync scrapes the source with async polling runtime and parsing
+// enabled, and decodes the parsed content of the results into T.
+func scrapeParsedAsync[T any](
+	ctx context.Context,
+	c *SerpClientAsync,
+	source oxylabs.Source,
+	input string,
+	params oxylabs.Params,
+) (chan *response.Resp[T], error) {
+	respChan := make(chan *response.Resp[T])
+	params["parse"] = true
+
+	// Check validity of parameters and prepare payload.
+	payload, err := sources.BuildPayload(source, input, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := response.ScrapeParsed[T](ctx, c.C, payload, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Forward the response to the resp channel.
+	go func() {
+		respChan <- res
+	}()
+
+	return respChan, nil
+}
@@ -0,0 +1,187 @@
+package serp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *SerpClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &SerpClient{
+		C: &internal.Client{
+			BaseUrl: server.URL,
+			ApiCredentials: &internal.ApiCredentials{
+				Username: "username",
+				Password: "password",
+			},
+			HttpClient: server.Client(),
+		},
+	}
+}
+
+func TestSerpClient_ScrapeGoogleSearchParsed(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, true, payload["parse"])
+		assert.Equal(t, "google_search", payload["source"])
+
+		w.Write([]byte(`{
+			"results": [{
+				"content": {
+					"url": "https://www.google.com/search?q=adidas",
+					"page": 1,
+					"results": {
+						"organic": [{"pos": 1, "url": "https://www.adidas.com", "title": "adidas"}],
+						"total_results_count": 100
+					},
+					"parse_status_code": 12000
+				},
+				"page": 1,
+				"status_code": 200,
+				"parser_type": "v2"
+			}],
+			"job": {"id": "123", "source": "google_search"}
+		}`))
+	})
+
+	resp, err := c.ScrapeGoogleSearchParsed("adidas")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "123", resp.Job.ID)
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, "v2", resp.Results[0].ParserType)
+	assert.Equal(t, oxylabs.NewInt(12000), content.ParseStatusCode)
+	assert.Equal(t, 100, content.Results.TotalResultsCount.Value)
+	assert.Equal(t, "adidas", content.Results.Organic[0].Title)
+}
+
+// hostTransport sends every request to the test server, whatever its host.
+type hostTransport struct {
+	server *httptest.Server
+}
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+func TestSerpClientAsync_ScrapeGoogleSearchParsed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries":
+			body, _ := io.ReadAll(r.Body)
+			var payload map[string]interface{}
+			assert.NoError(t, json.Unmarshal(body, &payload))
+			assert.Equal(t, true, payload["parse"])
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(parsedRespJSON))
+		}
+	}))
+	defer server.Close()
+
+	c := InitAsync("username", "password")
+	c.C.BaseUrl = server.URL + "/v1/queries"
+	c.C.HttpClient = &http.Client{Transport: hostTransport{server}}
+
+	respChan, err := c.ScrapeGoogleSearchParsed("adidas")
+	assert.NoError(t, err)
+
+	resp := <-respChan
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, []string{"Could not parse videos."}, content.Warnings)
+	assert.Contains(t, content.Results.Extra, "ai_overview")
+}
+
+func TestSerpClient_ScrapeBingSearchParsedParseInstructions(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	instructions := map[string]interface{}{
+		"title": map[string]interface{}{
+			"_fns": []map[string]interface{}{{"_fn": "xpath_one", "_args": []string{"//title/text()"}}},
+		},
+	}
+	_, err := c.ScrapeBingSearchParsed("adidas", &BingSearchOpts{ParseInstructions: &instructions})
+	assert.ErrorContains(t, err, "parse instructions cannot be used with typed responses")
+}
+
+func TestGetResp_TypedKeepsUnmappedFields(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	recorder.WriteString(parsedRespJSON)
+
+	resp, err := response.GetResp[GoogleSearchContent](recorder.Result(), true, false)
+	assert.NoError(t, err)
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, []string{"Could not parse videos."}, content.Warnings)
+	assert.Contains(t, content.Extra, "new_field")
+	assert.Contains(t, content.Results.Extra, "ai_overview")
+}
+
+func TestSerpClient_ScrapeGoogleSearchParsedStrictParse(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(failedRespJSON))
+	})
+
+	resp, err := c.ScrapeGoogleSearchParsed("adidas")
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.ParseStatus(12002), resp.Results[1].ParseStatus)
+	assert.Len(t, resp.Failed(), 1)
+
+	c.EnableStrictParse()
+	resp, err = c.ScrapeGoogleSearchParsed("adidas")
+	assert.Nil(t, resp)
+	var parseErr *oxylabs.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 2, parseErr.Failures[0].Page)
+}
+
+func TestSerpClient_ScrapeGoogleSearchParsedStrictDecode(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "google_search.json"))
+	assert.NoError(t, err)
+
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	})
+
+	// The sitelinks array fails the decoding outside of the strict decode mode.
+	_, err = c.ScrapeGoogleSearchParsed("adidas")
+	assert.ErrorContains(t, err, "sitelinks")
+
+	var logged []oxylabs.Diagnostic
+	c.EnableStrictDecode(func(diagnostics []oxylabs.Diagnostic) {
+		logged = append(logged, diagnostics...)
+	})
+
+	resp, err := c.ScrapeGoogleSearchParsed("adidas")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Diagnostics)
+	assert.Equal(t, resp.Diagnostics, logged)
+	assert.NotEmpty(t, resp.Results[0].ContentParsed.Results.Organic)
+}